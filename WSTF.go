@@ -1,14 +1,14 @@
 package readability
 
 import (
+	"context"
+	"embed"
 	"errors"
-	"fmt"
+	"io"
+	"io/fs"
 	"io/ioutil"
-	"os"
-	"strings"
-	"unicode/utf8"
+	"sort"
 
-	"github.com/blevesearch/segment"
 	"github.com/neurosnap/sentences"
 	"github.com/speedata/hyphenation"
 )
@@ -21,74 +21,94 @@ const (
 	WSTF2
 	WSTF3
 	WSTF4
+	FleschAmstad
+	FleschReadingEase
+	FleschKincaid
+	GunningFog
+	SMOG
+	ColemanLiau
+	ARI
+	LIX
+	RIX
+	KandelMoles
+	SzigrisztPazos
+	Gulpease
+	FleschDouma
+	HIX
 )
 
 type Readability struct {
-	tokenizer *sentences.DefaultSentenceTokenizer
-	hyphen    *hyphenation.Lang
-	lang      string
+	sentencesplitter SentenceSplitter
+	wordsplitter     WordSplitter
+	hyphen           *hyphenation.Lang
+	lang             string
+
+	// minimum number of characters before the first and after the last hyphenation point
+	lefthyphenmin, righthyphenmin int
+	// regional normalization applied to words before hyphenation, may be nil
+	normalize func(string) string
+	// nil if the engine has neither hyphenation patterns nor a syllable counter configured
+	syllables SyllableCounter
+	// words whose hyphenation overrides the patterns
+	exceptions *hyphenationexceptions
 }
 
 // Implements the Wiener Sachtextformel according to
 // https://de.wikipedia.org/wiki/Lesbarkeitsindex#Wiener_Sachtextformel
 // Further reading at https://wwwmatthes.in.tum.de/file/1f2t6qd87twtm/Sebis-Public-Website/-/Comparison-of-Law-Texts-An-Analysis-of-German-and-Austrian-Legislation-regarding-Linguistic-and-Structural-Metrics/Wa15a.pdf
+// MS is the share of words with three or more syllables, ES the share of words with one syllable.
 func (r *Readability) WienerSachTextFormelType(text string, WSTF_Type CompareType) (float32, error) {
+	return r.WienerSachTextFormelTypeContext(context.Background(), text, WSTF_Type)
+}
+
+// WienerSachTextFormelTypeContext is WienerSachTextFormelType, stopping with the error of ctx once ctx is done.
+func (r *Readability) WienerSachTextFormelTypeContext(ctx context.Context, text string, WSTF_Type CompareType) (float32, error) {
 
 	if !(WSTF_Type == WSTF1 || WSTF_Type == WSTF2 || WSTF_Type == WSTF3 || WSTF_Type == WSTF4) {
-		return 0, errors.New(fmt.Sprintf("Unknown compare type provided to WienerSachTextFormelType: %d", WSTF_Type))
+		return 0, newerror(ErrUnknownCompareType, "Unknown compare type provided to WienerSachTextFormelType: %d", WSTF_Type)
 	}
 
-	if r.lang != "de" {
-		return 0, errors.New("WienerSachTextFormelType operates only on german text")
+	if baselanguage(r.lang) != "de" {
+		return 0, newerror(ErrUnsupportedLanguage, "WienerSachTextFormelType operates only on german text")
 	}
 
-	var cnt_sentences, cnt_words, cnt_words_3psyllables, cnt_words_1syllable, cnt_wordlen_p6chars int
-	// split input in sentences
-	sentences := r.tokenizer.Tokenize(text)
-	for _, val := range sentences {
-
-		// split sentences into words
-		segmenter := segment.NewWordSegmenter(strings.NewReader(val.Text))
-
-		for segmenter.Segment() {
-
-			if segmenter.Type() != segment.Letter {
-				continue
-			}
-			word := segmenter.Text()
-			wordlen := utf8.RuneCountInString(word)
-
-			// count syllables in words
-			hyp := r.hyphen.Hyphenate(word)
-
-			if len(hyp) >= 3 {
-				cnt_words_3psyllables++
-			} else if len(hyp) == 1 {
-				cnt_words_1syllable++
-			}
+	ts, err := r.AnalyzeContext(ctx, text)
+	if err != nil {
+		return 0, err
+	}
+	return ts.WienerSachTextFormelType(WSTF_Type)
+}
 
-			if wordlen > 6 {
-				cnt_wordlen_p6chars++
-			}
-			cnt_words++
+// Computes the Wiener Sachtextformel from previously gathered text statistics.
+// cf. Readability.WienerSachTextFormelType
+func (ts *TextStatistics) WienerSachTextFormelType(WSTF_Type CompareType) (float32, error) {
 
-		}
+	if !(WSTF_Type == WSTF1 || WSTF_Type == WSTF2 || WSTF_Type == WSTF3 || WSTF_Type == WSTF4) {
+		return 0, newerror(ErrUnknownCompareType, "Unknown compare type provided to WienerSachTextFormelType: %d", WSTF_Type)
+	}
 
-		cnt_sentences++
+	if baselanguage(ts.Lang) != "de" {
+		return 0, newerror(ErrUnsupportedLanguage, "WienerSachTextFormelType operates only on german text")
+	}
 
+	if err := ts.checkwords("WienerSachTextFormelType"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("WienerSachTextFormelType"); err != nil {
+		return 0, err
 	}
 
-	var MS = float32(cnt_words_3psyllables) / float32(cnt_words) * 100
-	var SL = float32(cnt_words) / float32(cnt_sentences)
+	var MS = float32(ts.Polysyllables) / float32(ts.Words) * 100
+	var SL = float32(ts.Words) / float32(ts.Sentences)
 	var wstfretval float32
 
 	switch WSTF_Type {
 	case WSTF1:
-		var IW = 0.1297 * float32(cnt_wordlen_p6chars) / float32(cnt_words) * 100
-		var ES = 0.0327 * float32(cnt_words_1syllable) / float32(cnt_words) * 100
+		var IW = 0.1297 * float32(ts.LongWords) / float32(ts.Words) * 100
+		var ES = 0.0327 * float32(ts.Monosyllables) / float32(ts.Words) * 100
 		wstfretval = 0.1935*MS + 0.1672*SL + IW - ES - 0.875
 	case WSTF2:
-		var IW = 0.1373 * float32(cnt_wordlen_p6chars) / float32(cnt_words) * 100
+		var IW = 0.1373 * float32(ts.LongWords) / float32(ts.Words) * 100
 		wstfretval = 0.2007*MS + 0.1682*SL + IW - 2.779
 	case WSTF3:
 		wstfretval = 0.2963*MS + 0.1905*SL - 1.1144
@@ -108,44 +128,189 @@ func (r *Readability) WienerSachTextFormel(text string) (float32, error) {
 
 type initalisationfilename struct {
 	segmentationfilename, hyphenfileame string
+	// hyphenation exceptions belonging to the hyphenation patterns, may be empty
+	hyphenexceptionsfilename string
 }
 
 var initalisationfilenames = map[string]initalisationfilename{
-	"de": initalisationfilename{"data/german.json", "data/hyphen/hyph-de-1996.pat.txt"},
+	"de": initalisationfilename{"data/german.json", "data/hyphen/hyph-de-1996.pat.txt", ""},
+	"en": initalisationfilename{"data/english.json", "data/hyphen/hyph-en-us.pat.txt", "data/hyphen/hyph-en-us.hyp.txt"},
+	"fr": initalisationfilename{"data/french.json", "data/hyphen/hyph-fr.pat.txt", ""},
+	"es": initalisationfilename{"data/spanish.json", "data/hyphen/hyph-es.pat.txt", ""},
+	"it": initalisationfilename{"data/italian.json", "data/hyphen/hyph-it.pat.txt", ""},
+	"nl": initalisationfilename{"data/dutch.json", "data/hyphen/hyph-nl.pat.txt", ""},
 }
 
+// Returns the languages a Readability Engine can be initialized for, including regional variants like de-AT
+func Languages() []string {
+	var langs []string
+	for lang := range initalisationfilenames {
+		langs = append(langs, lang)
+	}
+	for lang := range languagevariants {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// the language resources of all languages in initalisationfilenames, shipped with the package
+//
+//go:embed data
+var shippedresources embed.FS
+
 // Initializes the Readability Engine by reading language-specific hypenation patterns and sentence training data.
+// The resources are shipped with the package, so no files are required at runtime.
+// Options may replace the resources or adjust the defaults.
 // Returns a Readability-Object or error, if initialisation failes
-func NewReadability(lang string) (*Readability, error) {
-	var r Readability
-	// create the default sentence tokenizer
+func NewReadability(lang string, opts ...Option) (*Readability, error) {
+	return NewReadabilityFS(shippedresources, lang, opts...)
+}
+
+// Initializes the Readability Engine from the language-specific resources found in fsys.
+// The resources are expected at the same paths as they are shipped with the package,
+// e.g. data/german.json and data/hyphen/hyph-de-1996.pat.txt for german, english additionally requires
+// the hyphenation exceptions data/hyphen/hyph-en-us.hyp.txt.
+// lang is a BCP-47 language tag, resolved by ResolveLanguage.
+func NewReadabilityFS(fsys fs.FS, lang string, opts ...Option) (*Readability, error) {
 
-	f, err := os.Open(initalisationfilenames[lang].segmentationfilename)
+	lang, err := ResolveLanguage(lang)
 	if err != nil {
-		return nil, err
+		return nil, newerror(err, "NewReadability: %s", err.Error())
+	}
+	resources := initalisationfilenames[baselanguage(lang)]
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	training := o.training
+	if training == nil {
+		f, err := fsys.Open(resources.segmentationfilename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		training = f
+	}
+
+	// Languages without hyphenation patterns are restricted to formulas which do not count syllables
+	hyphenpatterns := o.hyphenpatterns
+	hyphenfilename := resources.hyphenfileame
+	if o.shippedhyphenpatterns != "" {
+		// shipped patterns are always read from the package resources
+		fsys, hyphenfilename = shippedresources, o.shippedhyphenpatterns
+	}
+	if hyphenpatterns == nil && hyphenfilename != "" {
+		f, err := fsys.Open(hyphenfilename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		hyphenpatterns = f
+	}
+	// the exceptions of the default patterns are added first, so exceptions of the options override them
+	if o.hyphenpatterns == nil && o.shippedhyphenpatterns == "" && resources.hyphenexceptionsfilename != "" {
+		f, err := fsys.Open(resources.hyphenexceptionsfilename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		o.hyphenationexceptions = append([]io.Reader{f}, o.hyphenationexceptions...)
+	}
+
+	return newreadability(lang, training, hyphenpatterns, &o)
+}
+
+// Initializes the Readability Engine from Punkt sentence training data in JSON format and TeX hyphenation patterns.
+// hyphenpatterns may be nil, the engine is then restricted to formulas which do not count syllables.
+// Options replacing the resources are ignored.
+func NewReadabilityFromReader(lang string, training io.Reader, hyphenpatterns io.Reader, opts ...Option) (*Readability, error) {
+	lang, err := ResolveLanguage(lang)
+	if err != nil {
+		return nil, newerror(err, "NewReadabilityFromReader: %s", err.Error())
+	}
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
 	}
-	b, err := ioutil.ReadAll(f)
+	return newreadability(lang, training, hyphenpatterns, &o)
+}
+
+func newreadability(lang string, training io.Reader, hyphenpatterns io.Reader, o *options) (*Readability, error) {
+	r := Readability{lang: lang}
+
+	// create the default sentence tokenizer
+	b, err := ioutil.ReadAll(training)
 	if err != nil {
 		return nil, err
 	}
 
-	training, err := sentences.LoadTraining(b)
+	storage, err := sentences.LoadTraining(b)
 	if err != nil {
 		return nil, errors.New("NewReadabily.LoadTraining failed: " + err.Error())
 	}
-	r.tokenizer = sentences.NewSentenceTokenizer(training)
+	if storage.AbbrevTypes == nil {
+		storage.AbbrevTypes = sentences.SetString{}
+	}
+	if storage.Collocations == nil {
+		storage.Collocations = sentences.SetString{}
+	}
+	variant := languagevariants[lang]
+	for _, abbreviation := range append(variant.abbreviations, o.abbreviations...) {
+		storage.AbbrevTypes.Add(abbreviationtype(abbreviation))
+	}
+	for _, collocation := range variant.collocations {
+		storage.Collocations.Add(collocation)
+	}
+	r.normalize = variant.normalize
+	tokenizer := sentences.NewSentenceTokenizer(storage)
+	switch {
+	case o.sentencesplitter != nil:
+		r.sentencesplitter = o.sentencesplitter
+	case o.structured:
+		r.sentencesplitter = NewPunktSentenceSplitter(NewStructuredSentenceTokenizer(tokenizer))
+	default:
+		r.sentencesplitter = NewPunktSentenceSplitter(tokenizer)
+	}
+	r.wordsplitter = o.wordsplitter
+	if r.wordsplitter == nil {
+		r.wordsplitter = NewSegmentWordSplitter()
+	}
 
 	// create the hyphenation
-	f, err = os.Open(initalisationfilenames[lang].hyphenfileame)
-	if err != nil {
-		return nil, err
+	if hyphenpatterns != nil {
+		l, err := loadhyphenation(hyphenpatterns)
+		if err != nil {
+			return nil, err
+		}
+		r.hyphen = l
 	}
-	l, err := hyphenation.New(f)
-	if err != nil {
-		return nil, err
+	r.lefthyphenmin, r.righthyphenmin = o.lefthyphenmin, o.righthyphenmin
+	r.exceptions = &hyphenationexceptions{}
+	for _, exceptions := range o.hyphenationexceptions {
+		words, err := readhyphenationexceptions(exceptions)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.exceptions.add(words...); err != nil {
+			return nil, err
+		}
+	}
+
+	// german uses the dedicated syllable counter, other languages count hyphenation points
+	switch {
+	case o.newsyllablecounter != nil:
+		r.syllables = o.newsyllablecounter(r.Hyphenate)
+	case r.hyphen == nil:
+		// no syllable data
+	case baselanguage(lang) == "de":
+		r.syllables = NewGermanSyllableCounter(r.Hyphenate)
+	default:
+		r.syllables = newlanguagesyllablecounter(r.Hyphenate, lang)
 	}
-	r.hyphen = l
 
-	r.lang = lang
 	return &r, nil
 }
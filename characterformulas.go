@@ -0,0 +1,65 @@
+package readability
+
+// The formulas in this file are based on characters, words and sentences only. As they do not require
+// syllable counts, they can be computed for every language a Readability engine can be initialized for.
+
+// Computes the Coleman-Liau Index:
+// CLI = 0.0588 * L - 0.296 * S - 15.8
+// where L is the average number of letters and S the average number of sentences per 100 words.
+// cf. https://en.wikipedia.org/wiki/Coleman%E2%80%93Liau_index
+func (ts *TextStatistics) ColemanLiau() (float32, error) {
+
+	if err := ts.checkwords("ColemanLiau"); err != nil {
+		return 0, err
+	}
+
+	var L = float32(ts.Letters) / float32(ts.Words) * 100
+	var S = float32(ts.Sentences) / float32(ts.Words) * 100
+
+	return 0.0588*L - 0.296*S - 15.8, nil
+}
+
+// Computes the Automated Readability Index:
+// ARI = 4.71 * characters / words + 0.5 * words / sentences - 21.43
+// where numbers count as words, as their digits count as characters.
+// cf. https://en.wikipedia.org/wiki/Automated_readability_index
+func (ts *TextStatistics) AutomatedReadabilityIndex() (float32, error) {
+
+	if err := ts.checkwords("AutomatedReadabilityIndex"); err != nil {
+		return 0, err
+	}
+
+	words := float32(ts.Words + ts.Numbers)
+	var CPW = float32(ts.Characters) / words
+	var SL = words / float32(ts.Sentences)
+
+	return 4.71*CPW + 0.5*SL - 21.43, nil
+}
+
+// Computes Björnsson's Läsbarhetsindex:
+// LIX = words / sentences + 100 * long words / words
+// where long words are words longer than six characters.
+// cf. https://de.wikipedia.org/wiki/Lesbarkeitsindex#LIX
+func (ts *TextStatistics) LIX() (float32, error) {
+
+	if err := ts.checkwords("LIX"); err != nil {
+		return 0, err
+	}
+
+	var SL = float32(ts.Words) / float32(ts.Sentences)
+	var LW = float32(ts.LongWords) / float32(ts.Words) * 100
+
+	return SL + LW, nil
+}
+
+// Computes Anderson's Readability Index:
+// RIX = long words / sentences
+// where long words are words longer than six characters.
+// cf. https://en.wikipedia.org/wiki/Lix_(readability_test)
+func (ts *TextStatistics) RIX() (float32, error) {
+
+	if err := ts.checkwords("RIX"); err != nil {
+		return 0, err
+	}
+	return float32(ts.LongWords) / float32(ts.Sentences), nil
+}
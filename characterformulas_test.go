@@ -0,0 +1,41 @@
+package readability
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAutomatedReadabilityIndexNumbers(t *testing.T) {
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	withnumbers, err := r.Analyze("Im Jahr 2023 wurden 150000 Euro ausgegeben.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withnumbers.Words != 5 || withnumbers.Numbers != 2 || withnumbers.Characters != 36 {
+		t.Fatalf("unexpected statistics %+v", withnumbers)
+	}
+	// 4.71 * 36 / 7 + 0.5 * 7 - 21.43
+	ari, err := withnumbers.AutomatedReadabilityIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(float64(ari)-6.293) > 0.01 {
+		t.Errorf("ARI %g, expected 6.29", ari)
+	}
+
+	// the digits of numbers no longer inflate the characters per word
+	withoutnumbers, err := r.Analyze("Im Jahr wurden Euro ausgegeben.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := withoutnumbers.AutomatedReadabilityIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(float64(ari-base)) > 1 {
+		t.Errorf("ARI %g with numbers, %g without", ari, base)
+	}
+}
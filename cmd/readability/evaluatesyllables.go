@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/the42/readability"
+)
+
+func init() {
+	commands = append(commands, command{
+		name:  "evaluate-syllables",
+		usage: "compare every syllable counter against a gold standard word list (word<TAB>syllables)",
+		run:   evaluatesyllables,
+	})
+}
+
+func evaluatesyllables(args []string) error {
+	flags := flag.NewFlagSet("evaluate-syllables", flag.ExitOnError)
+	lang := flags.String("lang", "de", "language of the gold standard word list")
+	worst := flags.Int("worst", 20, "number of worst errors to report per syllable counter")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return errors.New("evaluate-syllables: expected exactly one gold standard file")
+	}
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gold, err := readability.ReadSyllableGold(f)
+	if err != nil {
+		return err
+	}
+
+	r, err := readability.NewReadability(*lang)
+	if err != nil {
+		return err
+	}
+	counters := r.SyllableCounters()
+	if len(counters) == 0 {
+		return errors.New(fmt.Sprintf("evaluate-syllables: no syllable counter available for language %s", *lang))
+	}
+
+	var names []string
+	for name := range counters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		printsyllableevaluation(os.Stdout, readability.EvaluateSyllableCounter(name, counters[name], gold), *worst)
+	}
+	return nil
+}
+
+func printsyllableevaluation(w io.Writer, e readability.SyllableEvaluation, worst int) {
+	fmt.Fprintf(w, "%s: %d of %d words correct, accuracy %.1f%%\n", e.Counter, e.Correct, e.Words, 100*e.Accuracy)
+
+	// confusion matrix, rows are the correct counts, columns the counted ones
+	var gold, counted []int
+	seen := make(map[int]bool)
+	for g, row := range e.Confusion {
+		gold = append(gold, g)
+		for c := range row {
+			if !seen[c] {
+				seen[c] = true
+				counted = append(counted, c)
+			}
+		}
+	}
+	sort.Ints(gold)
+	sort.Ints(counted)
+
+	fmt.Fprintf(w, "  %6s", "gold")
+	for _, c := range counted {
+		fmt.Fprintf(w, " %4d", c)
+	}
+	fmt.Fprintln(w)
+	for _, g := range gold {
+		fmt.Fprintf(w, "  %6d", g)
+		for _, c := range counted {
+			fmt.Fprintf(w, " %4d", e.Confusion[g][c])
+		}
+		fmt.Fprintln(w)
+	}
+
+	if worst > len(e.Errors) {
+		worst = len(e.Errors)
+	}
+	if worst < 0 {
+		worst = 0
+	}
+	if worst > 0 {
+		fmt.Fprintln(w, "  worst errors:")
+	}
+	for _, err := range e.Errors[:worst] {
+		fmt.Fprintf(w, "    %-30s %d instead of %d\n", err.Word, err.Counted, err.Syllables)
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/the42/readability"
+)
+
+// constantcounter counts the same number of syllables for every word
+type constantcounter int
+
+func (c constantcounter) Syllables(string) int { return int(c) }
+
+func TestPrintSyllableEvaluationWorst(t *testing.T) {
+	gold := []readability.SyllableGold{{Word: "Haus", Syllables: 1}, {Word: "Gemeinde", Syllables: 3}}
+	e := readability.EvaluateSyllableCounter("two", constantcounter(2), gold)
+
+	for worst, errors := range map[int]int{-1: 0, 0: 0, 1: 1, 20: 2} {
+		var b bytes.Buffer
+		printsyllableevaluation(&b, e, worst)
+		if got := strings.Count(b.String(), "instead of"); got != errors {
+			t.Errorf("worst %d: expected %d errors, got %d", worst, errors, got)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Command readability bundles tools around the readability package.
+//
+// Usage:
+//
+//	readability evaluate-syllables [-lang de] [-worst 20] gold.tsv
+//	readability score [-lang de] [-format text|html|markdown|docx|odt] document
+//	readability train-punkt [-abbreviations gem.,lt.,Abs.] [-abbreviations-file file] [-ext .txt] [-o german.json] directory
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands []command
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: readability <command> [arguments]\n\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-20s %s\n", c.name, c.usage)
+	}
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("readability: ")
+
+	sort.Slice(commands, func(i, j int) bool { return commands[i].name < commands[j].name })
+	if len(os.Args) < 2 {
+		usage()
+	}
+	for _, c := range commands {
+		if c.name == os.Args[1] {
+			if err := c.run(os.Args[2:]); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+	usage()
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/the42/readability"
+)
+
+func init() {
+	commands = append(commands, command{
+		name:  "score",
+		usage: "compute every readability score of a text, html, markdown, docx or odt file",
+		run:   score,
+	})
+}
+
+func score(args []string) error {
+	flags := flag.NewFlagSet("score", flag.ExitOnError)
+	lang := flags.String("lang", "de", "language of the document")
+	format := flags.String("format", "", "format of the document: text, html, markdown, docx or odt. Derived from the file extension by default")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return errors.New("score: expected exactly one document")
+	}
+	name := flags.Arg(0)
+
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), ".")
+		switch *format {
+		case "htm":
+			*format = "html"
+		case "txt":
+			*format = "text"
+		}
+	}
+
+	r, err := readability.NewReadability(*lang)
+	if err != nil {
+		return err
+	}
+	var ts *readability.TextStatistics
+	if *format == "text" {
+		// plain text needs no extraction, so even very large files are analyzed without reading them in whole
+		ts, err = analyzefile(r, name)
+	} else {
+		var document *readability.Document
+		if document, err = readdocument(name, *format); err == nil {
+			ts, err = r.AnalyzeDocument(document)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("sentences %d, words %d, syllables %d, reliability %.2f\n", ts.Sentences, ts.Words, ts.Syllables, ts.Reliability())
+	for _, t := range r.CompareTypes() {
+		s, err := ts.Score(t)
+		if err != nil {
+			return err
+		}
+		interpretation, err := r.Interpret(t, s)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%-20s %8.2f  %-12s grade %2d\n", t, s, interpretation.Label, interpretation.Grade)
+	}
+	return nil
+}
+
+// analyzefile streams the plain text file name through the engine
+func analyzefile(r *readability.Readability, name string) (*readability.TextStatistics, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return r.AnalyzeReader(f)
+}
+
+// readdocument reads the file name in format and extracts its text
+func readdocument(name, format string) (*readability.Document, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch format {
+	case "docx", "odt":
+		info, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if format == "docx" {
+			return readability.ReadDOCX(f, info.Size())
+		}
+		return readability.ReadODT(f, info.Size())
+	}
+
+	textformat, err := readability.ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return readability.NewDocument(string(b), textformat), nil
+}
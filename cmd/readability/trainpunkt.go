@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/the42/readability"
+)
+
+func init() {
+	commands = append(commands, command{
+		name:  "train-punkt",
+		usage: "build sentence training data from a directory of plain text files, loadable by NewReadability",
+		run:   trainpunkt,
+	})
+}
+
+func trainpunkt(args []string) error {
+	flags := flag.NewFlagSet("train-punkt", flag.ExitOnError)
+	output := flags.String("o", "", "write the training data to this file instead of stdout")
+	abbreviations := flags.String("abbreviations", "", "comma separated list of abbreviations to add, e.g. \"gem.,lt.,Abs.\"")
+	abbreviationsfile := flags.String("abbreviations-file", "", "file with one abbreviation to add per line")
+	extension := flags.String("ext", ".txt", "extension of the plain text files to read")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return errors.New("train-punkt: expected exactly one directory of plain text files")
+	}
+
+	var manual []string
+	if *abbreviations != "" {
+		manual = strings.Split(*abbreviations, ",")
+	}
+	if *abbreviationsfile != "" {
+		f, err := os.Open(*abbreviationsfile)
+		if err != nil {
+			return err
+		}
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			if line := strings.TrimSpace(s.Text()); line != "" {
+				manual = append(manual, line)
+			}
+		}
+		f.Close()
+		if err := s.Err(); err != nil {
+			return err
+		}
+	}
+
+	trainer := readability.NewSentenceTrainer()
+	err := filepath.Walk(flags.Arg(0), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != *extension {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return trainer.Train(f)
+	})
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(trainer.Storage(manual...))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/the42/readability"
+)
+
+func TestTrainPunkt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trainpunkt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	corpus := map[string]string{
+		"antrag.txt":   "Der Antrag ist gem. Abs. 2 schriftlich zu stellen. Die Frist beträgt gem. Abs. 3 vier Wochen.",
+		"bescheid.txt": "Die Behörde entscheidet gem. Abs. 1 ohne Verzug. Gegen den Bescheid ist gem. Abs. 4 eine Beschwerde zulässig.",
+		"kraft.txt":    "Die Verordnung ist im BGBl. II Nr. 7 veröffentlicht. Sie tritt gem. Abs. 5 mit dem Folgetag in Kraft.",
+		// other extensions are skipped
+		"notes.md": "Abs. Abs. Abs.",
+	}
+	for name, text := range corpus {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	output := filepath.Join(dir, "german.json")
+	if err := trainpunkt([]string{"-abbreviations", "BGBl.,Nr.", "-o", output, dir}); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r, err := readability.NewReadability("de", readability.WithSentenceTraining(f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := r.Analyze("Die Frist beginnt gem. Abs. 2 mit der Zustellung. Das Gesetz wurde im BGBl. I Nr. 12 verkündet. Der Antrag ist gem. Abs. 3 einzubringen.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.Sentences != 3 {
+		t.Errorf("expected 3 sentences, got %d", ts.Sentences)
+	}
+}
+
+func TestTrainPunktArguments(t *testing.T) {
+	if err := trainpunkt(nil); err == nil {
+		t.Error("expected an error without a directory")
+	}
+}
@@ -1,10 +1,18 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	restful "github.com/emicklei/go-restful"
 	"github.com/emicklei/go-restful/swagger"
@@ -15,42 +23,229 @@ import (
 type ReadabilityRequest struct {
 	CheckString     *string `description:"Input String whose readability should be checked"`
 	CorrelationID   *string `description:"request provided CorrelationID copied to response for requests/response matchmaking"`
-	ReadabilityType *string `description:"Algorithm to use for readability check"`
+	ReadabilityType *string `description:"Algorithm to use for readability check. May be a comma separated list of algorithms or ALL"`
+	Language        *string `description:"BCP-47 language tag of CheckString: de, de-AT, de-CH, en, es, fr, it or nl. Defaults to de"`
+	Detail          *string `description:"comma separated list of details to return additionally. sentences: the readability of every sentence, hardest first. words: the annotation of every word. confidence: a 95% confidence interval of every score"`
+	Format          *string `description:"markup of CheckString: text, html or markdown. Defaults to text. Offsets of sentences and words refer to CheckString"`
 }
 
 type ReadabilityResponse struct {
 	ReadabilityRequest ReadabilityRequest
 	Response           struct {
-		Readability float32 `description:"Readability score result"`
-		Message     *string `description:"diagnostic message returned by readability ccheck"`
-		StatusCode  int     `description:"0:success, -1: no success, check Message"`
+		Readability    float32                                   `description:"Readability score result of the first requested algorithm"`
+		Interpretation *readability.Interpretation               `description:"Difficulty band, school grade and audience of Readability, labelled in german for german text, in english otherwise"`
+		Readabilities  map[string]float32                        `description:"Readability score results of all requested algorithms"`
+		HIXComponents  []readability.HIXComponent                `description:"Sub-measures of the HIX, if requested"`
+		Reliability    float32                                   `description:"0: the scores are close to noise to 1: enough words and sentences for reliable scores"`
+		Confidence     map[string]readability.ConfidenceInterval `description:"Bootstrap confidence interval of every score, if requested by Detail"`
+		Sentences      []readability.SentenceStatistics          `description:"Readability of every sentence, if requested by Detail"`
+		Words          []readability.WordAnnotation              `description:"Annotation of every word, if requested by Detail"`
+		Message        *string                                   `description:"diagnostic message returned by readability ccheck"`
+		StatusCode     int                                       `description:"0: success, -1: invalid request, -2: empty text, -3: text without words, -4: unsupported language, -5: unknown readability type, -6: analysis timed out or cancelled, -7: insufficient text, -8: document too large. Check Message unless 0"`
 	}
 }
 type PortalReadabilityRequest struct {
 	CKANMDAustria   *portalwatch.CKANMDAustria `description:"the raw CKAN metadata harvested"`
 	CorrelationID   *string                    `description:"request provided CorrelationID copied to response for requests/response matchmaking"`
-	ReadabilityType *string                    `description:"Algorithm to use for readability check"`
+	ReadabilityType *string                    `description:"Algorithm to use for readability check. May be a comma separated list of algorithms or ALL"`
+	Language        *string                    `description:"BCP-47 language tag of the metadata: de, de-AT, de-CH, en, es, fr, it or nl. Defaults to de"`
 }
 
 type PortalReadabilityResponse struct {
-	PortalReadabilityRequest PortalReadabilityRequest `description:"Copied to response from Request`
+	PortalReadabilityRequest PortalReadabilityRequest `description:"Copied to response from Request"`
 	Response                 struct {
-		Readability float32 `description:"Readability score result"`
-		CheckString *string `description:"The actual tested string"`
-		Message     *string `description:"diagnostic message returned by readability ccheck"`
-		StatusCode  int     `description:"0:success, -1: no success, check Message"`
+		Readability    float32                     `description:"Readability score result of the first requested algorithm"`
+		Interpretation *readability.Interpretation `description:"Difficulty band, school grade and audience of Readability, labelled in german for german text, in english otherwise"`
+		Readabilities  map[string]float32          `description:"Readability score results of all requested algorithms"`
+		HIXComponents  []readability.HIXComponent  `description:"Sub-measures of the HIX, if requested"`
+		Reliability    float32                     `description:"0: the scores are close to noise to 1: enough words and sentences for reliable scores"`
+		CheckString    *string                     `description:"The actual tested string"`
+		Message        *string                     `description:"diagnostic message returned by readability ccheck"`
+		StatusCode     int                         `description:"0: success, -1: invalid request, -2: empty text, -3: text without words, -4: unsupported language, -5: unknown readability type, -6: analysis timed out or cancelled, -7: insufficient text, -8: document too large. Check Message unless 0"`
 	}
 }
 
+type UploadReadabilityResponse struct {
+	FileName        string  `description:"name of the uploaded file"`
+	Format          string  `description:"format the uploaded file was read as"`
+	Language        *string `description:"language form value copied to response"`
+	ReadabilityType *string `description:"readabilitytype form value copied to response"`
+	Response        struct {
+		Readability    float32                     `description:"Readability score result of the first requested algorithm"`
+		Interpretation *readability.Interpretation `description:"Difficulty band, school grade and audience of Readability, labelled in german for german text, in english otherwise"`
+		Readabilities  map[string]float32          `description:"Readability score results of all requested algorithms"`
+		HIXComponents  []readability.HIXComponent  `description:"Sub-measures of the HIX, if requested"`
+		Reliability    float32                     `description:"0: the scores are close to noise to 1: enough words and sentences for reliable scores"`
+		Message        *string                     `description:"diagnostic message returned by readability ccheck"`
+		StatusCode     int                         `description:"0: success, -1: invalid request, -2: empty text, -3: text without words, -4: unsupported language, -5: unknown readability type, -6: analysis timed out or cancelled, -7: insufficient text, -8: document too large. Check Message unless 0"`
+	}
+}
+
+// values of Response.StatusCode
+const (
+	statussuccess             = 0
+	statusinvalidrequest      = -1
+	statusemptytext           = -2
+	statusnowords             = -3
+	statusunsupportedlanguage = -4
+	statusunknowncomparetype  = -5
+	statustimeout             = -6
+	statusinsufficienttext    = -7
+	statusdocumenttoolarge    = -8
+)
+
+// errinsufficienttext is returned if a text has fewer words than configured by MIN_WORDS
+var errinsufficienttext = errors.New("insufficient text")
+
+// failure is implemented by every response to report an error in Response.StatusCode and Response.Message
+type failure interface {
+	fail(statuscode int, message string)
+}
+
+func (r *ReadabilityResponse) fail(statuscode int, message string) {
+	r.Response.StatusCode, r.Response.Message = statuscode, &message
+}
+
+func (r *PortalReadabilityResponse) fail(statuscode int, message string) {
+	r.Response.StatusCode, r.Response.Message = statuscode, &message
+}
+
+func (r *UploadReadabilityResponse) fail(statuscode int, message string) {
+	r.Response.StatusCode, r.Response.Message = statuscode, &message
+}
+
 var readabilityrequesttypemappings = map[string]readability.CompareType{
-	"WSTF1": readability.WSTF1,
-	"WSTF2": readability.WSTF2,
-	"WSTF3": readability.WSTF3,
-	"WSTF4": readability.WSTF4,
+	"WSTF1":             readability.WSTF1,
+	"WSTF2":             readability.WSTF2,
+	"WSTF3":             readability.WSTF3,
+	"WSTF4":             readability.WSTF4,
+	"FleschAmstad":      readability.FleschAmstad,
+	"FleschReadingEase": readability.FleschReadingEase,
+	"FleschKincaid":     readability.FleschKincaid,
+	"GunningFog":        readability.GunningFog,
+	"SMOG":              readability.SMOG,
+	"ColemanLiau":       readability.ColemanLiau,
+	"ARI":               readability.ARI,
+	"LIX":               readability.LIX,
+	"RIX":               readability.RIX,
+	"KandelMoles":       readability.KandelMoles,
+	"SzigrisztPazos":    readability.SzigrisztPazos,
+	"Gulpease":          readability.Gulpease,
+	"FleschDouma":       readability.FleschDouma,
+	"HIX":               readability.HIX,
 }
 
 type readabilityservice struct {
-	r *readability.Readability
+	engines map[string]*readability.Readability
+	// maximum duration of the analysis of a single request, no limit if 0
+	timeout time.Duration
+	// minimum number of words of a text to compute scores for
+	minwords int
+	// maximum size in bytes of an upload request
+	maxupload int64
+}
+
+// defaulttimeout limits the analysis of a request unless REQUEST_TIMEOUT is set
+const defaulttimeout = 30 * time.Second
+
+// defaultmaxupload limits the size of an upload request unless MAX_UPLOAD_SIZE is set
+const defaultmaxupload = 10 * 1024 * 1024
+
+// context returns the context of request, limited to the timeout configured.
+// The analysis stops once the client has gone away or the timeout has passed.
+func (s *readabilityservice) context(request *restful.Request) (context.Context, context.CancelFunc) {
+	if s.timeout > 0 {
+		return context.WithTimeout(request.Request.Context(), s.timeout)
+	}
+	return context.WithCancel(request.Request.Context())
+}
+
+// engine returns the readability engine for the requested language, german if no language is requested
+func (s *readabilityservice) engine(language *string) (*readability.Readability, error) {
+	lang := "de"
+	if language != nil && len(*language) > 0 {
+		resolved, err := readability.ResolveLanguage(*language)
+		if err != nil {
+			return nil, fmt.Errorf("no readability engine available for language %s: %w", *language, err)
+		}
+		lang = resolved
+	}
+	return s.engines[lang], nil
+}
+
+// readabilitytypes maps the requested ReadabilityType to compare types. It defaults to the first algorithm supported
+// by the engine (WSTF1 for german) and accepts a comma separated list of algorithms or ALL for every algorithm supported.
+// Returns an error wrapping readability.ErrUnknownCompareType if an algorithm is unknown.
+func readabilitytypes(r *readability.Readability, readabilitytype *string) ([]readability.CompareType, error) {
+	if readabilitytype == nil || len(*readabilitytype) == 0 {
+		return r.CompareTypes()[:1], nil
+	}
+	if strings.EqualFold(*readabilitytype, "ALL") {
+		return r.CompareTypes(), nil
+	}
+
+	var readability_types []readability.CompareType
+	for _, name := range strings.Split(*readabilitytype, ",") {
+		readability_type, ok := readabilityrequesttypemappings[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("no method found to perform readability check %s: %w", strings.TrimSpace(name), readability.ErrUnknownCompareType)
+		}
+		readability_types = append(readability_types, readability_type)
+	}
+	return readability_types, nil
+}
+
+// checkreadability analyzes text once and computes all requested readability formulas and their reliability.
+// If the HIX is requested, its sub-measures are returned as well.
+func (s *readabilityservice) checkreadability(ctx context.Context, r *readability.Readability, document *readability.Document, readability_types []readability.CompareType) (map[readability.CompareType]float32, []readability.HIXComponent, float32, error) {
+	ts, err := r.AnalyzeDocumentContext(ctx, document)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if ts.Words < s.minwords {
+		return nil, nil, 0, fmt.Errorf("%w: %d words, at least %d required", errinsufficienttext, ts.Words, s.minwords)
+	}
+
+	readabilities := make(map[readability.CompareType]float32, len(readability_types))
+	var hixcomponents []readability.HIXComponent
+	for _, readability_type := range readability_types {
+		score, err := ts.Score(readability_type)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		readabilities[readability_type] = score
+
+		if readability_type == readability.HIX {
+			if hixcomponents, err = ts.HIXComponents(); err != nil {
+				return nil, nil, 0, err
+			}
+		}
+	}
+	return readabilities, hixcomponents, ts.Reliability(), nil
+}
+
+// readabilitiesbyname keys the readability scores by the names used in requests
+func readabilitiesbyname(readabilities map[readability.CompareType]float32) map[string]float32 {
+	result := make(map[string]float32, len(readabilities))
+	for name, readability_type := range readabilityrequesttypemappings {
+		if score, ok := readabilities[readability_type]; ok {
+			result[name] = score
+		}
+	}
+	return result
+}
+
+// detailrequested reports whether name is contained in the comma separated list of details
+func detailrequested(detail *string, name string) bool {
+	if detail == nil {
+		return false
+	}
+	for _, d := range strings.Split(*detail, ",") {
+		if strings.TrimSpace(d) == name {
+			return true
+		}
+	}
+	return false
 }
 
 func appendclosingiandblank(in string) string {
@@ -68,12 +263,7 @@ func (s *readabilityservice) portalreadabilityservice(request *restful.Request,
 
 	readabilityrequest := PortalReadabilityRequest{}
 	if err := request.ReadEntity(&readabilityrequest); err != nil {
-		logresponse(response, http.StatusBadRequest, fmt.Sprintf("unable to parse request: %s", err.Error()))
-		return
-	}
-
-	if readabilityrequest.CKANMDAustria == nil {
-		logresponse(response, http.StatusBadRequest, fmt.Sprintf("PortalReadabilityRequest.CKANMDAustriaportal is required but not set"))
+		failresponse(response, &PortalReadabilityResponse{}, err, fmt.Sprintf("unable to parse request: %s", err.Error()))
 		return
 	}
 
@@ -81,11 +271,20 @@ func (s *readabilityservice) portalreadabilityservice(request *restful.Request,
 	// set the input struct to nil for performance reasons
 	result.PortalReadabilityRequest.CKANMDAustria = nil
 
-	var readability_type readability.CompareType
-	if readabilityrequest.ReadabilityType != nil && len(*readabilityrequest.ReadabilityType) > 0 {
-		readability_type = readabilityrequesttypemappings[*readabilityrequest.ReadabilityType]
-	} else {
-		readability_type = readability.WSTF1
+	if readabilityrequest.CKANMDAustria == nil {
+		failresponse(response, &result, nil, "PortalReadabilityRequest.CKANMDAustriaportal is required but not set")
+		return
+	}
+
+	r, err := s.engine(readabilityrequest.Language)
+	if err != nil {
+		failresponse(response, &result, err, err.Error())
+		return
+	}
+	readability_types, err := readabilitytypes(r, readabilityrequest.ReadabilityType)
+	if err != nil {
+		failresponse(response, &result, err, err.Error())
+		return
 	}
 
 	// prepare input data for readability check
@@ -111,19 +310,22 @@ func (s *readabilityservice) portalreadabilityservice(request *restful.Request,
 
 	result.Response.CheckString = &readability_inputstring
 
-	switch readability_type {
-	case readability.WSTF1, readability.WSTF2, readability.WSTF3, readability.WSTF4:
-		readabilityresult, err := s.r.WienerSachTextFormelType(readability_inputstring, readability_type)
-		if err != nil {
-			logresponse(response, http.StatusBadRequest, fmt.Sprintf("WienerSachTextFormelType returned error: %s", err.Error()))
-			return
-		}
-		result.Response.Readability = readabilityresult
-	default:
-		result.Response.StatusCode = -1
-		s := "no method found to perform readability check"
-		result.Response.Message = &s
+	ctx, cancel := s.context(request)
+	defer cancel()
+
+	readabilityresults, hixcomponents, reliability, err := s.checkreadability(ctx, r, readability.NewDocument(readability_inputstring, readability.PlainText), readability_types)
+	if err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("readability check returned error: %s", err.Error()))
+		return
 	}
+	result.Response.Readability = readabilityresults[readability_types[0]]
+	if result.Response.Interpretation, err = r.Interpret(readability_types[0], result.Response.Readability); err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("Interpret returned error: %s", err.Error()))
+		return
+	}
+	result.Response.Readabilities = readabilitiesbyname(readabilityresults)
+	result.Response.HIXComponents = hixcomponents
+	result.Response.Reliability = reliability
 	response.WriteAsJson(result)
 }
 
@@ -131,44 +333,220 @@ func (s *readabilityservice) readabilityservice(request *restful.Request, respon
 
 	readabilityrequest := ReadabilityRequest{}
 	if err := request.ReadEntity(&readabilityrequest); err != nil {
-		logresponse(response, http.StatusBadRequest, fmt.Sprintf("unable to parse request: %s", err.Error()))
+		failresponse(response, &ReadabilityResponse{}, err, fmt.Sprintf("unable to parse request: %s", err.Error()))
 		return
 	}
+
+	result := ReadabilityResponse{ReadabilityRequest: readabilityrequest}
+	// set the input string to nil for performance reasons. May correlate result to request by using CorrelationID
+	result.ReadabilityRequest.CheckString = nil
+
 	if readabilityrequest.CheckString == nil {
-		logresponse(response, http.StatusBadRequest, fmt.Sprintf("ReadabilitySimpleRequest.CheckString is required but not set"))
+		failresponse(response, &result, nil, "ReadabilitySimpleRequest.CheckString is required but not set")
 		return
 	}
 
-	var readability_type readability.CompareType
+	r, err := s.engine(readabilityrequest.Language)
+	if err != nil {
+		failresponse(response, &result, err, err.Error())
+		return
+	}
+	readability_types, err := readabilitytypes(r, readabilityrequest.ReadabilityType)
+	if err != nil {
+		failresponse(response, &result, err, err.Error())
+		return
+	}
 
-	if readabilityrequest.ReadabilityType != nil && len(*readabilityrequest.ReadabilityType) > 0 {
-		readability_type = readabilityrequesttypemappings[*readabilityrequest.ReadabilityType]
-	} else {
-		readability_type = readability.WSTF1
+	var format readability.Format
+	if readabilityrequest.Format != nil {
+		if format, err = readability.ParseFormat(*readabilityrequest.Format); err != nil {
+			failresponse(response, &result, err, err.Error())
+			return
+		}
 	}
+	document := readability.NewDocument(*readabilityrequest.CheckString, format)
 
-	result := ReadabilityResponse{ReadabilityRequest: readabilityrequest}
-	// set the input string to nil for performance reasons. May correlate result to request by using CorrelationID
-	result.ReadabilityRequest.CheckString = nil
+	ctx, cancel := s.context(request)
+	defer cancel()
 
-	switch readability_type {
-	case readability.WSTF1, readability.WSTF2, readability.WSTF3, readability.WSTF4:
-		readabilityresult, err := s.r.WienerSachTextFormelType(*readabilityrequest.CheckString, readability_type)
+	readabilityresults, hixcomponents, reliability, err := s.checkreadability(ctx, r, document, readability_types)
+	if err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("readability check returned error: %s", err.Error()))
+		return
+	}
+	result.Response.Readability = readabilityresults[readability_types[0]]
+	if result.Response.Interpretation, err = r.Interpret(readability_types[0], result.Response.Readability); err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("Interpret returned error: %s", err.Error()))
+		return
+	}
+	result.Response.Readabilities = readabilitiesbyname(readabilityresults)
+	result.Response.HIXComponents = hixcomponents
+	result.Response.Reliability = reliability
+
+	if detailrequested(readabilityrequest.Detail, "sentences") {
+		// the sentence breakdown is based on the WSTF requested, WSTF1 otherwise
+		var wstf_type readability.CompareType = readability.WSTF1
+		switch readability_types[0] {
+		case readability.WSTF1, readability.WSTF2, readability.WSTF3, readability.WSTF4:
+			wstf_type = readability_types[0]
+		}
+		sentences, err := r.AnalyzeDocumentSentencesContext(ctx, document, wstf_type)
+		if err != nil {
+			failresponse(response, &result, err, fmt.Sprintf("AnalyzeSentences returned error: %s", err.Error()))
+			return
+		}
+		result.Response.Sentences = sentences
+	}
+	if detailrequested(readabilityrequest.Detail, "confidence") {
+		confidence, err := r.BootstrapDocumentContext(ctx, document, 0.95, readability_types...)
+		if err != nil {
+			failresponse(response, &result, err, fmt.Sprintf("Bootstrap returned error: %s", err.Error()))
+			return
+		}
+		result.Response.Confidence = make(map[string]readability.ConfidenceInterval, len(confidence))
+		for readability_type, interval := range confidence {
+			result.Response.Confidence[readability_type.String()] = interval
+		}
+	}
+	if detailrequested(readabilityrequest.Detail, "words") {
+		words, err := r.AnnotateDocumentWordsContext(ctx, document)
 		if err != nil {
-			logresponse(response, http.StatusBadRequest, fmt.Sprintf("WienerSachTextFormelType returned error: %s", err.Error()))
+			failresponse(response, &result, err, fmt.Sprintf("AnnotateWords returned error: %s", err.Error()))
 			return
 		}
-		result.Response.Readability = readabilityresult
-	default:
-		result.Response.StatusCode = -1
-		s := "no method found to perform readability check"
-		result.Response.Message = &s
+		result.Response.Words = words
 	}
 	response.WriteAsJson(result)
 }
-func logresponse(resp *restful.Response, code int, message string) {
-	resp.WriteErrorString(code, message)
+
+// uploaddocument extracts the text of an uploaded file. The format defaults to the file name extension.
+func uploaddocument(name, format string, content []byte) (*readability.Document, string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), ".")
+		switch format {
+		case "htm":
+			format = "html"
+		case "txt":
+			format = "text"
+		case "md":
+			format = "markdown"
+		}
+	}
+
+	switch format {
+	case "docx":
+		document, err := readability.ReadDOCX(bytes.NewReader(content), int64(len(content)))
+		return document, format, err
+	case "odt":
+		document, err := readability.ReadODT(bytes.NewReader(content), int64(len(content)))
+		return document, format, err
+	}
+	textformat, err := readability.ParseFormat(format)
+	if err != nil {
+		return nil, format, errors.New(fmt.Sprintf("unknown format %s, expected docx, odt, html, markdown or text", format))
+	}
+	return readability.NewDocument(string(content), textformat), format, nil
+}
+
+func (s *readabilityservice) uploadreadabilityservice(request *restful.Request, response *restful.Response) {
+
+	// the whole request is limited, the form is kept in memory up to the same size
+	request.Request.Body = http.MaxBytesReader(response.ResponseWriter, request.Request.Body, s.maxupload)
+	if err := request.Request.ParseMultipartForm(s.maxupload); err != nil {
+		var toolarge *http.MaxBytesError
+		if errors.As(err, &toolarge) {
+			err = fmt.Errorf("upload exceeds the limit of %d bytes: %w", s.maxupload, readability.ErrDocumentTooLarge)
+		}
+		failresponse(response, &UploadReadabilityResponse{}, err, fmt.Sprintf("unable to read uploaded file: %s", err.Error()))
+		return
+	}
+	file, header, err := request.Request.FormFile("file")
+	if err != nil {
+		failresponse(response, &UploadReadabilityResponse{}, err, fmt.Sprintf("unable to read uploaded file: %s", err.Error()))
+		return
+	}
+	defer file.Close()
+
+	result := UploadReadabilityResponse{FileName: header.Filename}
+	if language := request.Request.FormValue("language"); language != "" {
+		result.Language = &language
+	}
+	if readabilitytype := request.Request.FormValue("readabilitytype"); readabilitytype != "" {
+		result.ReadabilityType = &readabilitytype
+	}
+
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("unable to read uploaded file: %s", err.Error()))
+		return
+	}
+
+	r, err := s.engine(result.Language)
+	if err != nil {
+		failresponse(response, &result, err, err.Error())
+		return
+	}
+	readability_types, err := readabilitytypes(r, result.ReadabilityType)
+	if err != nil {
+		failresponse(response, &result, err, err.Error())
+		return
+	}
+	document, format, err := uploaddocument(header.Filename, request.Request.FormValue("format"), content)
+	if err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("unable to extract text of %s: %s", header.Filename, err.Error()))
+		return
+	}
+	result.Format = format
+
+	ctx, cancel := s.context(request)
+	defer cancel()
+
+	readabilityresults, hixcomponents, reliability, err := s.checkreadability(ctx, r, document, readability_types)
+	if err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("readability check returned error: %s", err.Error()))
+		return
+	}
+	result.Response.Readability = readabilityresults[readability_types[0]]
+	if result.Response.Interpretation, err = r.Interpret(readability_types[0], result.Response.Readability); err != nil {
+		failresponse(response, &result, err, fmt.Sprintf("Interpret returned error: %s", err.Error()))
+		return
+	}
+	result.Response.Readabilities = readabilitiesbyname(readabilityresults)
+	result.Response.HIXComponents = hixcomponents
+	result.Response.Reliability = reliability
+	response.WriteAsJson(result)
+}
+
+// errorstatus maps an error to the HTTP status and the Response.StatusCode returned.
+// An analysis which ran out of time is reported as 503, one given up by the client as 408.
+func errorstatus(err error) (int, int) {
+	switch {
+	case errors.Is(err, readability.ErrEmptyText):
+		return http.StatusUnprocessableEntity, statusemptytext
+	case errors.Is(err, readability.ErrNoWords):
+		return http.StatusUnprocessableEntity, statusnowords
+	case errors.Is(err, errinsufficienttext):
+		return http.StatusUnprocessableEntity, statusinsufficienttext
+	case errors.Is(err, readability.ErrUnsupportedLanguage), errors.Is(err, readability.ErrNoSyllables):
+		return http.StatusBadRequest, statusunsupportedlanguage
+	case errors.Is(err, readability.ErrUnknownCompareType):
+		return http.StatusBadRequest, statusunknowncomparetype
+	case errors.Is(err, readability.ErrDocumentTooLarge):
+		return http.StatusRequestEntityTooLarge, statusdocumenttoolarge
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusServiceUnavailable, statustimeout
+	case errors.Is(err, context.Canceled):
+		return http.StatusRequestTimeout, statustimeout
+	}
+	return http.StatusBadRequest, statusinvalidrequest
+}
+
+// failresponse sends result with the status derived from err and message. err may be nil for an invalid request.
+func failresponse(response *restful.Response, result failure, err error, message string) {
+	status, statuscode := errorstatus(err)
+	result.fail(statuscode, message)
 	log.Print(message)
+	response.WriteHeaderAndJson(status, result, restful.MIME_JSON)
 }
 
 func main() {
@@ -194,12 +572,41 @@ func main() {
 	}
 	//END: CORS support
 
-	s := &readabilityservice{}
-	if r, err := readability.NewReadability("de"); err == nil {
-		s.r = r
-	} else {
-		log.Fatalf("Cannot create NewReadability Instance: %s\n", err.Error())
-		return
+	s := &readabilityservice{engines: make(map[string]*readability.Readability), timeout: defaulttimeout, maxupload: defaultmaxupload}
+	// REQUEST_TIMEOUT limits the analysis of a single request, e.g. 10s. 0 disables the limit
+	if timeout := os.Getenv("REQUEST_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			log.Fatalf("Cannot parse REQUEST_TIMEOUT %s: %s\n", timeout, err.Error())
+			return
+		}
+		s.timeout = d
+	}
+	// MIN_WORDS is the number of words below which a text is rejected as insufficient instead of scored
+	if minwords := os.Getenv("MIN_WORDS"); minwords != "" {
+		n, err := strconv.Atoi(minwords)
+		if err != nil {
+			log.Fatalf("Cannot parse MIN_WORDS %s: %s\n", minwords, err.Error())
+			return
+		}
+		s.minwords = n
+	}
+	// MAX_UPLOAD_SIZE is the size in bytes above which an uploaded document is rejected
+	if maxupload := os.Getenv("MAX_UPLOAD_SIZE"); maxupload != "" {
+		n, err := strconv.ParseInt(maxupload, 10, 64)
+		if err != nil || n <= 0 {
+			log.Fatalf("Cannot parse MAX_UPLOAD_SIZE %s\n", maxupload)
+			return
+		}
+		s.maxupload = n
+	}
+	for _, lang := range readability.Languages() {
+		if r, err := readability.NewReadability(lang); err == nil {
+			s.engines[lang] = r
+		} else {
+			log.Fatalf("Cannot create NewReadability Instance for language %s: %s\n", lang, err.Error())
+			return
+		}
 	}
 
 	ws.Route(ws.PUT("/readability").
@@ -210,7 +617,10 @@ func main() {
 		Reads(ReadabilityRequest{}).
 		Returns(http.StatusOK, "success", ReadabilityResponse{}).
 		Returns(http.StatusInternalServerError, "failure", nil).
-		Returns(http.StatusBadRequest, "failure", nil))
+		Returns(http.StatusBadRequest, "invalid request, unsupported language or unknown readability type", ReadabilityResponse{}).
+		Returns(http.StatusUnprocessableEntity, "text empty, without words or shorter than MIN_WORDS", ReadabilityResponse{}).
+		Returns(http.StatusRequestTimeout, "client gave up waiting for the result", ReadabilityResponse{}).
+		Returns(http.StatusServiceUnavailable, "analysis did not finish within REQUEST_TIMEOUT", ReadabilityResponse{}))
 	ws.Route(ws.PUT("/portalreadability").
 		To(s.portalreadabilityservice).
 		Produces(restful.MIME_JSON).
@@ -219,7 +629,26 @@ func main() {
 		Reads(PortalReadabilityRequest{}).
 		Returns(http.StatusOK, "success", PortalReadabilityResponse{}).
 		Returns(http.StatusInternalServerError, "failure", nil).
-		Returns(http.StatusBadRequest, "failure", nil))
+		Returns(http.StatusBadRequest, "invalid request, unsupported language or unknown readability type", PortalReadabilityResponse{}).
+		Returns(http.StatusUnprocessableEntity, "text empty, without words or shorter than MIN_WORDS", PortalReadabilityResponse{}).
+		Returns(http.StatusRequestTimeout, "client gave up waiting for the result", PortalReadabilityResponse{}).
+		Returns(http.StatusServiceUnavailable, "analysis did not finish within REQUEST_TIMEOUT", PortalReadabilityResponse{}))
+	ws.Route(ws.POST("/readability/upload").
+		To(s.uploadreadabilityservice).
+		Produces(restful.MIME_JSON).
+		Consumes("multipart/form-data").
+		Doc("performs readability checks on an uploaded docx, odt, html, markdown or text file").
+		Param(ws.FormParameter("file", "the document to check").DataType("file")).
+		Param(ws.FormParameter("format", "docx, odt, html, markdown or text. Defaults to the file name extension").DataType("string")).
+		Param(ws.FormParameter("language", "BCP-47 language tag of the document. Defaults to de").DataType("string")).
+		Param(ws.FormParameter("readabilitytype", "Algorithm to use for readability check. May be a comma separated list of algorithms or ALL").DataType("string")).
+		Returns(http.StatusOK, "success", UploadReadabilityResponse{}).
+		Returns(http.StatusInternalServerError, "failure", nil).
+		Returns(http.StatusBadRequest, "invalid request, unsupported language or unknown readability type", UploadReadabilityResponse{}).
+		Returns(http.StatusUnprocessableEntity, "text empty, without words or shorter than MIN_WORDS", UploadReadabilityResponse{}).
+		Returns(http.StatusRequestEntityTooLarge, "upload larger than MAX_UPLOAD_SIZE or document content too large", UploadReadabilityResponse{}).
+		Returns(http.StatusRequestTimeout, "client gave up waiting for the result", UploadReadabilityResponse{}).
+		Returns(http.StatusServiceUnavailable, "analysis did not finish within REQUEST_TIMEOUT", UploadReadabilityResponse{}))
 	restful.Add(ws)
 
 	port := os.Getenv("PORT")
@@ -0,0 +1,176 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Format is the markup of a text handed to the readability engine
+type Format int
+
+const (
+	// PlainText is analyzed as is
+	PlainText Format = iota
+	// HTML tags, comments, scripts and styles are removed, entities are decoded
+	HTML
+	// Markdown markup like emphasis, headings, list markers, link URLs and code blocks is removed
+	Markdown
+)
+
+var formatnames = map[string]Format{
+	"":         PlainText,
+	"text":     PlainText,
+	"plain":    PlainText,
+	"html":     HTML,
+	"markdown": Markdown,
+	"md":       Markdown,
+}
+
+// ParseFormat maps the format names text, html and markdown to their Format
+func ParseFormat(name string) (Format, error) {
+	format, ok := formatnames[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return PlainText, errors.New(fmt.Sprintf("unknown format %s, expected text, html or markdown", name))
+	}
+	return format, nil
+}
+
+// Document is the plain text extracted from a marked up text. The text is divided into blocks like paragraphs,
+// headings, list items or table cells, and no sentence spans two blocks. Offsets within Text map back to the original.
+type Document struct {
+	Text string
+
+	original string
+	// byte ranges of the blocks within Text, nil if Text is a single block
+	blocks [][2]int
+	// original byte offset of every byte of Text and of its end, nil if Text is the original
+	offsets []int
+	// original byte offset following the original of every byte of Text
+	ends []int
+}
+
+// NewDocument removes the markup of text according to format
+func NewDocument(text string, format Format) *Document {
+	switch format {
+	case HTML:
+		return extracthtml(text)
+	case Markdown:
+		return extractmarkdown(text)
+	}
+	return &Document{Text: text, original: text}
+}
+
+// OriginalOffset maps a byte offset within Text to the byte offset within the original text
+func (d *Document) OriginalOffset(offset int) int {
+	if d.offsets == nil {
+		return offset
+	}
+	if offset < 0 {
+		return 0
+	}
+	if offset >= len(d.offsets) {
+		return len(d.original)
+	}
+	return d.offsets[offset]
+}
+
+// originalend maps the end offset of a range within Text to the end offset within the original text,
+// which includes the whole original of the last byte, like an HTML entity
+func (d *Document) originalend(end int) int {
+	if d.offsets == nil {
+		return end
+	}
+	if end <= 0 {
+		return 0
+	}
+	if end > len(d.ends) {
+		return len(d.original)
+	}
+	return d.ends[end-1]
+}
+
+// sentences splits every block of the document into sentences, offsets are within Text
+func (d *Document) sentences(splitter SentenceSplitter) []Sentence {
+	if d.blocks == nil {
+		return splitter.Sentences(d.Text)
+	}
+
+	var result []Sentence
+	for _, block := range d.blocks {
+		for _, s := range splitter.Sentences(d.Text[block[0]:block[1]]) {
+			s.Start, s.End = s.Start+block[0], s.End+block[0]
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// documentbuilder assembles the text of a Document together with the original offset of every byte
+type documentbuilder struct {
+	original   string
+	text       []byte
+	offsets    []int
+	ends       []int
+	blocks     [][2]int
+	blockstart int
+}
+
+func newdocumentbuilder(original string) *documentbuilder {
+	return &documentbuilder{original: original}
+}
+
+// copy appends original[from:to] unchanged
+func (b *documentbuilder) copy(from, to int) {
+	b.text = append(b.text, b.original[from:to]...)
+	for i := from; i < to; i++ {
+		b.offsets = append(b.offsets, i)
+		b.ends = append(b.ends, i+1)
+	}
+}
+
+// replace appends s in place of original[from:to], like a decoded entity
+func (b *documentbuilder) replace(s string, from, to int) {
+	b.text = append(b.text, s...)
+	for i := 0; i < len(s); i++ {
+		b.offsets = append(b.offsets, from)
+		b.ends = append(b.ends, to)
+	}
+}
+
+// endblock ends the current block at the original offset, blocks without any text are dropped
+func (b *documentbuilder) endblock(offset int) {
+	if strings.TrimFunc(string(b.text[b.blockstart:]), unicode.IsSpace) == "" {
+		return
+	}
+	b.blocks = append(b.blocks, [2]int{b.blockstart, len(b.text)})
+	b.replace("\n\n", offset, offset)
+	b.blockstart = len(b.text)
+}
+
+// textdocument returns the text assembled by replace as a Document of its own, for formats like DOCX
+// whose original is not text
+func (b *documentbuilder) textdocument() *Document {
+	b.endblock(0)
+	blocks := b.blocks
+	if blocks == nil {
+		blocks = [][2]int{}
+	}
+	return &Document{Text: string(b.text), original: string(b.text), blocks: blocks}
+}
+
+func (b *documentbuilder) document() *Document {
+	b.endblock(len(b.original))
+	blocks := b.blocks
+	if blocks == nil {
+		blocks = [][2]int{}
+	}
+	return &Document{
+		Text:     string(b.text),
+		original: b.original,
+		blocks:   blocks,
+		offsets:  append(b.offsets, len(b.original)),
+		ends:     b.ends,
+	}
+}
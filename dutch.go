@@ -0,0 +1,23 @@
+package readability
+
+// Computes the Flesch Reading Ease for dutch text, using the coefficients by Douma:
+// FRE = 206.835 - 0.93 * ASL - 77 * ASW
+// cf. https://nl.wikipedia.org/wiki/Leesbaarheid#Leesindex_van_Douma
+func (ts *TextStatistics) FleschDouma() (float32, error) {
+
+	if baselanguage(ts.Lang) != "nl" {
+		return 0, newerror(ErrUnsupportedLanguage, "FleschDouma operates only on dutch text")
+	}
+
+	if err := ts.checkwords("FleschDouma"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("FleschDouma"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var ASW = float32(ts.Syllables) / float32(ts.Words)
+
+	return 206.835 - 0.93*ASL - 77*ASW, nil
+}
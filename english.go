@@ -0,0 +1,90 @@
+package readability
+
+import "math"
+
+// Computes the Flesch Reading Ease for english text:
+// FRE = 206.835 - 1.015 * ASL - 84.6 * ASW
+// cf. https://en.wikipedia.org/wiki/Flesch%E2%80%93Kincaid_readability_tests#Flesch_reading_ease
+func (ts *TextStatistics) FleschReadingEase() (float32, error) {
+
+	if baselanguage(ts.Lang) != "en" {
+		return 0, newerror(ErrUnsupportedLanguage, "FleschReadingEase operates only on english text")
+	}
+
+	if err := ts.checkwords("FleschReadingEase"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("FleschReadingEase"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var ASW = float32(ts.Syllables) / float32(ts.Words)
+
+	return 206.835 - 1.015*ASL - 84.6*ASW, nil
+}
+
+// Computes the Flesch-Kincaid Grade Level for english text:
+// FKGL = 0.39 * ASL + 11.8 * ASW - 15.59
+// cf. https://en.wikipedia.org/wiki/Flesch%E2%80%93Kincaid_readability_tests#Flesch%E2%80%93Kincaid_grade_level
+func (ts *TextStatistics) FleschKincaidGrade() (float32, error) {
+
+	if baselanguage(ts.Lang) != "en" {
+		return 0, newerror(ErrUnsupportedLanguage, "FleschKincaidGrade operates only on english text")
+	}
+
+	if err := ts.checkwords("FleschKincaidGrade"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("FleschKincaidGrade"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var ASW = float32(ts.Syllables) / float32(ts.Words)
+
+	return 0.39*ASL + 11.8*ASW - 15.59, nil
+}
+
+// Computes the Gunning Fog Index for english text, complex words being words with three or more syllables:
+// FOG = 0.4 * (ASL + 100 * complex words / words)
+// cf. https://en.wikipedia.org/wiki/Gunning_fog_index
+func (ts *TextStatistics) GunningFog() (float32, error) {
+
+	if baselanguage(ts.Lang) != "en" {
+		return 0, newerror(ErrUnsupportedLanguage, "GunningFog operates only on english text")
+	}
+
+	if err := ts.checkwords("GunningFog"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("GunningFog"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var CW = float32(ts.Polysyllables) / float32(ts.Words) * 100
+
+	return 0.4 * (ASL + CW), nil
+}
+
+// Computes the SMOG grade for english text:
+// SMOG = 1.043 * sqrt(polysyllables * 30 / sentences) + 3.1291
+// cf. https://en.wikipedia.org/wiki/SMOG
+func (ts *TextStatistics) SMOG() (float32, error) {
+
+	if baselanguage(ts.Lang) != "en" {
+		return 0, newerror(ErrUnsupportedLanguage, "SMOG operates only on english text")
+	}
+
+	if err := ts.checkwords("SMOG"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("SMOG"); err != nil {
+		return 0, err
+	}
+
+	var PS = float64(ts.Polysyllables) * 30 / float64(ts.Sentences)
+
+	return float32(1.043*math.Sqrt(PS) + 3.1291), nil
+}
@@ -0,0 +1,53 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the package, possibly wrapped in a more detailed error. Check for them with errors.Is.
+var (
+	// the text to analyze is empty or consists of white space only
+	ErrEmptyText = errors.New("text is empty")
+	// the text contains no word to compute a readability formula from, e.g. only numbers or punctuation
+	ErrNoWords = errors.New("text contains no words")
+	// the language is unknown or a formula does not apply to the language of the engine
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+	// the compare type does not denote a readability formula
+	ErrUnknownCompareType = errors.New("unknown compare type")
+	// the formula is based on syllables, but the engine has neither hyphenation patterns nor a syllable counter
+	ErrNoSyllables = errors.New("syllables not counted")
+	// the document, or the content extracted from it, exceeds the size accepted
+	ErrDocumentTooLarge = errors.New("document too large")
+)
+
+// readabilityerror is an error with a detailed message which errors.Is matches against the error it wraps
+type readabilityerror struct {
+	err     error
+	message string
+}
+
+func (e *readabilityerror) Error() string { return e.message }
+
+func (e *readabilityerror) Unwrap() error { return e.err }
+
+// newerror returns an error wrapping err with a message formatted according to format
+func newerror(err error, format string, a ...interface{}) error {
+	return &readabilityerror{err: err, message: fmt.Sprintf(format, a...)}
+}
+
+// checkwords returns ErrNoWords if the statistics do not allow to compute the formula name
+func (ts *TextStatistics) checkwords(name string) error {
+	if ts.Words == 0 || ts.Sentences == 0 {
+		return newerror(ErrNoWords, "%s requires at least one word", name)
+	}
+	return nil
+}
+
+// checksyllables returns ErrNoSyllables if the syllables required by the formula name were not counted
+func (ts *TextStatistics) checksyllables(name string) error {
+	if ts.NoSyllables {
+		return newerror(ErrNoSyllables, "%s requires syllable counts, the engine has neither hyphenation patterns nor a syllable counter", name)
+	}
+	return nil
+}
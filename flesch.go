@@ -0,0 +1,38 @@
+package readability
+
+// Returns the Flesch Reading Ease of a text, using the coefficients adapted to german by Toni Amstad:
+// FRE = 180 - ASL - 58.5 * ASW
+// cf. https://de.wikipedia.org/wiki/Lesbarkeitsindex#Flesch-Reading-Ease
+func (r *Readability) FleschReadingEaseAmstad(text string) (float32, error) {
+
+	if baselanguage(r.lang) != "de" {
+		return 0, newerror(ErrUnsupportedLanguage, "FleschReadingEaseAmstad operates only on german text")
+	}
+
+	ts, err := r.Analyze(text)
+	if err != nil {
+		return 0, err
+	}
+	return ts.FleschReadingEaseAmstad()
+}
+
+// Computes Amstad's Flesch Reading Ease from previously gathered text statistics.
+// cf. Readability.FleschReadingEaseAmstad
+func (ts *TextStatistics) FleschReadingEaseAmstad() (float32, error) {
+
+	if baselanguage(ts.Lang) != "de" {
+		return 0, newerror(ErrUnsupportedLanguage, "FleschReadingEaseAmstad operates only on german text")
+	}
+
+	if err := ts.checkwords("FleschReadingEaseAmstad"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("FleschReadingEaseAmstad"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var ASW = float32(ts.Syllables) / float32(ts.Words)
+
+	return 180 - ASL - 58.5*ASW, nil
+}
@@ -0,0 +1,23 @@
+package readability
+
+// Computes the Flesch Reading Ease for french text, using the coefficients by Kandel & Moles:
+// FRE = 207 - 1.015 * ASL - 73.6 * ASW
+// cf. https://fr.wikipedia.org/wiki/Test_de_lisibilit%C3%A9_de_Flesch
+func (ts *TextStatistics) KandelMoles() (float32, error) {
+
+	if baselanguage(ts.Lang) != "fr" {
+		return 0, newerror(ErrUnsupportedLanguage, "KandelMoles operates only on french text")
+	}
+
+	if err := ts.checkwords("KandelMoles"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("KandelMoles"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var ASW = float32(ts.Syllables) / float32(ts.Words)
+
+	return 207 - 1.015*ASL - 73.6*ASW, nil
+}
@@ -0,0 +1,114 @@
+package readability
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HIXComponent is one of the sub-measures the Hohenheimer Verständlichkeitsindex is composed of
+type HIXComponent struct {
+	Name   string  `description:"name of the sub-measure"`
+	Value  float32 `description:"raw value of the sub-measure"`
+	Score  float32 `description:"value mapped onto the HIX scale, 0: very hard to 20: very easy"`
+	Weight float32 `description:"weight of the sub-measure within the HIX"`
+}
+
+// hixcomponent describes how a sub-measure is mapped onto the HIX scale: values at or below easy score 20,
+// values at or beyond hard score 0, values in between are interpolated linearly
+type hixcomponent struct {
+	name       string
+	weight     float32
+	easy, hard float32
+	value      func(ts *TextStatistics) (float32, error)
+}
+
+// The components of the HIX and their weights:
+//
+//	FleschAmstad       0.25  Amstad's Flesch Reading Ease, 100 and above is easy, 0 and below is hard
+//	WSTF1              0.25  first Wiener Sachtextformel, 4 and below is easy, 15 and above is hard
+//	SentenceLength     0.20  average words per sentence, 10 and below is easy, 30 and above is hard
+//	LongWords          0.15  percentage of words longer than six characters, 20 and below is easy, 60 and above is hard
+//	NominalStyle       0.15  percentage of nominalizations like -ung, -heit, -keit, 2 and below is easy, 15 and above is hard
+var hixcomponents = []hixcomponent{
+	{"FleschAmstad", 0.25, 100, 0, (*TextStatistics).FleschReadingEaseAmstad},
+	{"WSTF1", 0.25, 4, 15, func(ts *TextStatistics) (float32, error) { return ts.WienerSachTextFormelType(WSTF1) }},
+	{"SentenceLength", 0.20, 10, 30, func(ts *TextStatistics) (float32, error) {
+		return float32(ts.Words) / float32(ts.Sentences), nil
+	}},
+	{"LongWords", 0.15, 20, 60, func(ts *TextStatistics) (float32, error) {
+		return float32(ts.LongWords) / float32(ts.Words) * 100, nil
+	}},
+	{"NominalStyle", 0.15, 2, 15, func(ts *TextStatistics) (float32, error) {
+		return float32(ts.Nominalizations) / float32(ts.Words) * 100, nil
+	}},
+}
+
+// Computes the sub-measures of the Hohenheimer Verständlichkeitsindex for german text.
+// cf. https://klartext.uni-hohenheim.de/hix
+// The original weighting is not published, the components and weights used are documented at hixcomponents.
+func (ts *TextStatistics) HIXComponents() ([]HIXComponent, error) {
+
+	if baselanguage(ts.Lang) != "de" {
+		return nil, newerror(ErrUnsupportedLanguage, "HIX operates only on german text")
+	}
+
+	if err := ts.checkwords("HIX"); err != nil {
+		return nil, err
+	}
+	if err := ts.checksyllables("HIX"); err != nil {
+		return nil, err
+	}
+
+	result := make([]HIXComponent, 0, len(hixcomponents))
+	for _, c := range hixcomponents {
+		value, err := c.value(ts)
+		if err != nil {
+			return nil, err
+		}
+
+		score := 20 * (value - c.hard) / (c.easy - c.hard)
+		if score < 0 {
+			score = 0
+		} else if score > 20 {
+			score = 20
+		}
+		result = append(result, HIXComponent{Name: c.name, Value: value, Score: score, Weight: c.weight})
+	}
+	return result, nil
+}
+
+// Computes a Hohenheimer Verständlichkeitsindex for german text as the weighted sum of its components.
+// The scale ranges from 0: very hard to 20: very easy to understand.
+func (ts *TextStatistics) HIX() (float32, error) {
+
+	components, err := ts.HIXComponents()
+	if err != nil {
+		return 0, err
+	}
+
+	var hix float32
+	for _, c := range components {
+		hix += c.Weight * c.Score
+	}
+	return hix, nil
+}
+
+// german suffixes which turn verbs and adjectives into nouns
+var nominalizationsuffixes = []string{"ung", "ungen", "heit", "heiten", "keit", "keiten", "ion", "ionen", "ität", "itäten", "nis", "nisse", "schaft", "schaften", "tum", "tümer"}
+
+// isnominalization reports whether a german word is a noun derived by a nominalization suffix
+func isnominalization(word string) bool {
+
+	if first, _ := utf8.DecodeRuneInString(word); !unicode.IsUpper(first) {
+		return false
+	}
+
+	lower := strings.ToLower(word)
+	for _, suffix := range nominalizationsuffixes {
+		if strings.HasSuffix(lower, suffix) && len(lower) > len(suffix)+2 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package readability
+
+import (
+	"html"
+	"strings"
+)
+
+// elements which start and end a block of text, break included
+var htmlblockelements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true, "caption": true,
+	"dd": true, "div": true, "dl": true, "dt": true, "figcaption": true, "figure": true, "footer": true,
+	"form": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "header": true,
+	"hr": true, "li": true, "main": true, "nav": true, "ol": true, "p": true, "pre": true, "section": true,
+	"table": true, "td": true, "th": true, "title": true, "tr": true, "ul": true,
+}
+
+// elements whose content is not text
+var htmlskipelements = map[string]bool{"script": true, "style": true, "noscript": true, "template": true}
+
+// extracthtml removes tags, comments, scripts and styles and decodes entities. Block elements end blocks.
+func extracthtml(text string) *Document {
+	b := newdocumentbuilder(text)
+
+	for i := 0; i < len(text); {
+		switch {
+		case strings.HasPrefix(text[i:], "<!--"):
+			end := strings.Index(text[i+4:], "-->")
+			if end < 0 {
+				return b.document()
+			}
+			i += 4 + end + 3
+
+		case text[i] == '<' && i+1 < len(text) && (isasciiletter(text[i+1]) || text[i+1] == '/' || text[i+1] == '!' || text[i+1] == '?'):
+			end := strings.IndexByte(text[i:], '>')
+			if end < 0 {
+				b.copy(i, len(text))
+				return b.document()
+			}
+			name, closing := htmltagname(text[i+1 : i+end])
+			if htmlblockelements[name] {
+				b.endblock(i)
+			}
+			i += end + 1
+
+			if htmlskipelements[name] && !closing {
+				skip := indexasciifold(text[i:], "</"+name)
+				if skip < 0 {
+					return b.document()
+				}
+				i += skip
+			}
+
+		case text[i] == '&':
+			end := strings.IndexByte(text[i:], ';')
+			if end > 1 && end <= 32 {
+				if decoded := html.UnescapeString(text[i : i+end+1]); decoded != text[i:i+end+1] {
+					b.replace(decoded, i, i+end+1)
+					i += end + 1
+					continue
+				}
+			}
+			b.copy(i, i+1)
+			i++
+
+		default:
+			b.copy(i, i+1)
+			i++
+		}
+	}
+	return b.document()
+}
+
+// htmltagname returns the lower case name of a tag given without angle brackets and whether it is a closing tag
+func htmltagname(tag string) (string, bool) {
+	closing := strings.HasPrefix(tag, "/")
+	tag = strings.TrimPrefix(tag, "/")
+	end := strings.IndexAny(tag, " \t\r\n/")
+	if end >= 0 {
+		tag = tag[:end]
+	}
+	return strings.ToLower(tag), closing
+}
+
+// indexasciifold returns the byte index of the first instance of the lower case ASCII string substr in s,
+// ignoring the case of ASCII letters, or -1. Unlike searching a lower case copy, offsets of multi byte
+// characters whose lower case form is of different length are kept.
+func indexasciifold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		j := 0
+		for j < len(substr) && asciilower(s[i+j]) == substr[j] {
+			j++
+		}
+		if j == len(substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+func asciilower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+func isasciiletter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,50 @@
+package readability
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+// checkdocumentwords checks the words of text in format and that their offsets point at them in text
+func checkdocumentwords(t *testing.T, format Format, text string, want []string) {
+	t.Helper()
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	annotations, err := r.AnnotateDocumentWords(NewDocument(text, format))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(annotations) != len(want) {
+		t.Fatalf("%q: expected words %q, got %d annotations %+v", text, want, len(annotations), annotations)
+	}
+	for i, wa := range annotations {
+		if original := text[wa.Start:wa.End]; original != want[i] {
+			t.Errorf("%q: word %s at %d:%d is %q in the original, expected %q", text, wa.Text, wa.Start, wa.End, original, want[i])
+		}
+		if runestart := utf8.RuneCountInString(text[:wa.Start]); wa.RuneStart != runestart {
+			t.Errorf("%q: word %s at rune %d, expected %d", text, wa.Text, wa.RuneStart, runestart)
+		}
+		if runeend := utf8.RuneCountInString(text[:wa.End]); wa.RuneEnd != runeend {
+			t.Errorf("%q: word %s ends at rune %d, expected %d", text, wa.Text, wa.RuneEnd, runeend)
+		}
+	}
+}
+
+func TestHTMLOffsets(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"<p>Der <b>Hund</b> bellt.</p>", []string{"Der", "Hund", "bellt"}},
+		{"<p>Die Straße &amp; der Weg.</p><p>Stra&szlig;e</p>", []string{"Die", "Straße", "der", "Weg", "Stra&szlig;e"}},
+		// Ⱥ takes two bytes, its lower case form ⱥ three
+		{"<p>ȺȺȺ Größe.</p><script>var ȺȺȺȺ = '</p>';</SCRIPT><p>Der Hund bellt.</p>", []string{"ȺȺȺ", "Größe", "Der", "Hund", "bellt"}},
+		{"<STYLE>p::before { content: 'ȺȺȺȺȺȺ' }</Style><!-- Kommentar --><div>Übel ist es.</div>", []string{"Übel", "ist", "es"}},
+		{"<p>Köln</p><noscript>Ihr Browser unterstützt kein ȺȺ.</NoScript>Ende gut.", []string{"Köln", "Ende", "gut"}},
+	}
+	for _, test := range tests {
+		checkdocumentwords(t, HTML, test.text, test.want)
+	}
+}
@@ -0,0 +1,119 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// hyphenationexceptions are words whose hyphenation points are given explicitly and override the patterns,
+// like TeX's \hyphenation{} list. Words may be added at runtime, hence the lock.
+type hyphenationexceptions struct {
+	sync.RWMutex
+	// lower case word mapped to its hyphenation points as rune offsets
+	words map[string][]int
+}
+
+// hyphenate returns a copy of the hyphenation points of word and true if word is an exception.
+// The stored points are shared by all callers of the engine and must not be handed out.
+func (e *hyphenationexceptions) hyphenate(word string) ([]int, bool) {
+	e.RLock()
+	defer e.RUnlock()
+	hyp, ok := e.words[strings.ToLower(word)]
+	if !ok {
+		return nil, false
+	}
+	return append([]int(nil), hyp...), true
+}
+
+// add parses words hyphenated like geo-da-ten-por-tal and adds them, replacing the hyphenation of known words
+func (e *hyphenationexceptions) add(words ...string) error {
+	parsed := make(map[string][]int, len(words))
+	for _, w := range words {
+		word, hyp, err := parsehyphenationexception(w)
+		if err != nil {
+			return err
+		}
+		parsed[word] = hyp
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	if e.words == nil {
+		e.words = make(map[string][]int)
+	}
+	for word, hyp := range parsed {
+		e.words[word] = hyp
+	}
+	return nil
+}
+
+// parsehyphenationexception returns the lower case word without hyphens and the rune offsets of the hyphens
+func parsehyphenationexception(exception string) (string, []int, error) {
+	var word []rune
+	var hyp []int
+	for _, c := range strings.ToLower(exception) {
+		if c != '-' {
+			word = append(word, c)
+			continue
+		}
+		if len(word) == 0 || (len(hyp) > 0 && hyp[len(hyp)-1] == len(word)) {
+			return "", nil, errors.New(fmt.Sprintf("invalid hyphenation exception %q", exception))
+		}
+		hyp = append(hyp, len(word))
+	}
+	if len(word) == 0 || (len(hyp) > 0 && hyp[len(hyp)-1] == len(word)) {
+		return "", nil, errors.New(fmt.Sprintf("invalid hyphenation exception %q", exception))
+	}
+	return string(word), hyp, nil
+}
+
+// readhyphenationexceptions reads hyphenated words separated by white space. % starts a comment up to the end of line.
+// If the input contains TeX \hyphenation{...} blocks, only the words within these blocks are read.
+func readhyphenationexceptions(r io.Reader) ([]string, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	for _, line := range strings.Split(string(b), "\n") {
+		if i := strings.IndexRune(line, '%'); i >= 0 {
+			line = line[:i]
+		}
+		text.WriteString(line)
+		text.WriteByte('\n')
+	}
+
+	content := text.String()
+	if !strings.Contains(content, `\hyphenation`) {
+		return strings.FieldsFunc(content, unicode.IsSpace), nil
+	}
+
+	var words []string
+	for {
+		i := strings.Index(content, `\hyphenation`)
+		if i < 0 {
+			return words, nil
+		}
+		content = strings.TrimLeftFunc(content[i+len(`\hyphenation`):], unicode.IsSpace)
+		end := strings.IndexRune(content, '}')
+		if !strings.HasPrefix(content, "{") || end < 0 {
+			return nil, errors.New(`readhyphenationexceptions: \hyphenation without {...}`)
+		}
+		words = append(words, strings.FieldsFunc(content[1:end], unicode.IsSpace)...)
+		content = content[end+1:]
+	}
+}
+
+// AddHyphenationExceptions adds words hyphenated like "Geo-da-ten-por-tal" to the engine at runtime.
+// Their hyphenation overrides the patterns and the syllable counter, a word of n parts has n syllables. Case is ignored.
+// Words joined by hyphens like Open-Government-Data are analyzed as separate words, add their parts instead.
+// It is safe to call AddHyphenationExceptions while the engine is in use.
+func (r *Readability) AddHyphenationExceptions(words ...string) error {
+	return r.exceptions.add(words...)
+}
@@ -0,0 +1,151 @@
+package readability
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestShippedHyphenationExceptions(t *testing.T) {
+	f, err := shippedresources.Open("data/hyphen/hyph-en-us.hyp.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	words, err := readhyphenationexceptions(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(words) < 1000 {
+		t.Fatalf("expected the english exceptions, read %d words", len(words))
+	}
+
+	r, err := NewReadability("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range words {
+		word, want, err := parsehyphenationexception(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := r.Hyphenate(word); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: hyphenation points %v, expected %v of %s", word, got, want, w)
+		}
+	}
+	if got := r.Hyphenate("academies"); !reflect.DeepEqual(got, []int{4, 5}) {
+		t.Errorf("academies: hyphenation points %v, expected [4 5]", got)
+	}
+}
+
+func TestHyphenationExceptionsNotShared(t *testing.T) {
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddHyphenationExceptions("Geo-da-ten-por-tal-sei-te"); err != nil {
+		t.Fatal(err)
+	}
+	want := []int{3, 5, 8, 11, 14, 17}
+
+	annotations, err := r.AnnotateWords("Geodatenportalseite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(annotations) != 1 || !reflect.DeepEqual(annotations[0].Hyphens, want) {
+		t.Fatalf("unexpected annotation %+v", annotations)
+	}
+	// the caller owns the returned points
+	annotations[0].Hyphens[0] = 0
+	if got := r.Hyphenate("Geodatenportalseite"); !reflect.DeepEqual(got, want) {
+		t.Errorf("exception changed through an annotation, got %v", got)
+	}
+
+	// run with -race: counting syllables must not write to the stored exception
+	text := "Die Geodatenportalseite ist online."
+	expected, err := r.Analyze(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				ts, err := r.Analyze(text)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if *ts != *expected {
+					t.Errorf("expected %+v, got %+v", expected, ts)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestReadHyphenationExceptions(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"geo-da-ten\n  por-tal % Kommentar\n", []string{"geo-da-ten", "por-tal"}},
+		{"% Kopf\n\\hyphenation{ta-ble ex-am-ple}\naußerhalb\n\\hyphenation {Mi-lieu\n}", []string{"ta-ble", "ex-am-ple", "Mi-lieu"}},
+	}
+	for _, test := range tests {
+		got, err := readhyphenationexceptions(strings.NewReader(test.text))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: read %q, expected %q", test.text, got, test.want)
+		}
+	}
+	if _, err := readhyphenationexceptions(strings.NewReader("\\hyphenation{ta-ble")); err == nil {
+		t.Error("expected an error for an unterminated \\hyphenation")
+	}
+
+	for _, invalid := range []string{"-geo", "geo-", "geo--daten", "-"} {
+		if _, _, err := parsehyphenationexception(invalid); err == nil {
+			t.Errorf("%q: expected an error", invalid)
+		}
+	}
+	word, hyp, err := parsehyphenationexception("Geo-Da-ten")
+	if err != nil || word != "geodaten" || !reflect.DeepEqual(hyp, []int{3, 5}) {
+		t.Errorf("unexpected exception %s %v %v", word, hyp, err)
+	}
+}
+
+func TestHyphenationExceptionsOverrideSyllables(t *testing.T) {
+	r, err := NewReadability("de", WithHyphenationExceptions(strings.NewReader("\\hyphenation{Ge-mein-de-rat}")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddHyphenationExceptions("Mi-lieu", "In-ge-nieur"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]int{"Milieu": {2}, "Ingenieur": {2, 4}, "Gemeinderat": {2, 6, 8}}
+	annotations, err := r.AnnotateWords("Das Milieu der Ingenieur im Gemeinderat.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, wa := range annotations {
+		hyphens, ok := want[wa.Text]
+		if !ok {
+			continue
+		}
+		if !reflect.DeepEqual(wa.Hyphens, hyphens) || wa.Syllables != len(hyphens)+1 {
+			t.Errorf("%s: hyphens %v and %d syllables, expected %v and %d", wa.Text, wa.Hyphens, wa.Syllables, hyphens, len(hyphens)+1)
+		}
+		delete(want, wa.Text)
+	}
+	if len(want) > 0 {
+		t.Errorf("words not annotated: %v", want)
+	}
+}
@@ -0,0 +1,95 @@
+package readability
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sort"
+	"strconv"
+	"unicode"
+
+	"github.com/speedata/hyphenation"
+)
+
+// loadhyphenation reads TeX hyphenation patterns.
+// hyphenation.Lang never applies patterns consisting of a single letter like "1b", which the italian and spanish
+// patterns rely on. Such patterns are expanded to an equivalent pattern for every letter which may follow,
+// and to the end of word, and merged with the remaining patterns before they are handed to hyphenation.New.
+func loadhyphenation(r io.Reader) (*hyphenation.Lang, error) {
+
+	// letters of a pattern mapped to the priorities before, between and after them
+	patterns := make(map[string][]byte)
+	var single []string
+	alphabet := make(map[rune]bool)
+
+	s := bufio.NewScanner(r)
+	s.Split(bufio.ScanWords)
+	for s.Scan() {
+		var letters []rune
+		prios := []byte{0}
+		for _, v := range s.Text() {
+			if unicode.IsDigit(v) {
+				prios[len(prios)-1] = byte(v - '0')
+				continue
+			}
+			letters = append(letters, v)
+			prios = append(prios, 0)
+			if v != '.' {
+				alphabet[v] = true
+			}
+		}
+		key := string(letters)
+		mergepattern(patterns, key, prios)
+		if len(letters) == 1 && letters[0] != '.' {
+			single = append(single, key)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range single {
+		prios := patterns[key]
+		delete(patterns, key)
+		mergepattern(patterns, key+".", append(append([]byte{}, prios...), 0))
+		for v := range alphabet {
+			mergepattern(patterns, key+string(v), append(append([]byte{}, prios...), 0))
+		}
+	}
+
+	keys := make([]string, 0, len(patterns))
+	for key := range patterns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		prios := patterns[key]
+		for i, v := range []rune(key) {
+			if prios[i] != 0 {
+				buf.WriteString(strconv.Itoa(int(prios[i])))
+			}
+			buf.WriteRune(v)
+		}
+		if last := prios[len(prios)-1]; last != 0 {
+			buf.WriteString(strconv.Itoa(int(last)))
+		}
+		buf.WriteByte('\n')
+	}
+	return hyphenation.New(&buf)
+}
+
+// mergepattern adds a pattern, keeping the maximum priority of every position if the letters are already known
+func mergepattern(patterns map[string][]byte, key string, prios []byte) {
+	existing, ok := patterns[key]
+	if !ok {
+		patterns[key] = prios
+		return
+	}
+	for i := range existing {
+		if prios[i] > existing[i] {
+			existing[i] = prios[i]
+		}
+	}
+}
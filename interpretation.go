@@ -0,0 +1,146 @@
+package readability
+
+import "math"
+
+// DifficultyBand rates how hard a text is to read on a scale common to all readability formulas
+type DifficultyBand int
+
+const (
+	_ DifficultyBand = iota
+	VeryEasy
+	Easy
+	Medium
+	Hard
+	VeryHard
+)
+
+// Interpretation explains a readability score to readers not familiar with the scale of its formula
+type Interpretation struct {
+	Band     DifficultyBand `description:"1: very easy, 2: easy, 3: medium, 4: hard, 5: very hard"`
+	Label    string         `description:"name of the difficulty band, e.g. sehr leicht"`
+	Grade    int            `description:"approximate school grade required to read the text, 13 and above: university level"`
+	Audience string         `description:"readers the text is suited for"`
+}
+
+// gradeanchor maps a score of a formula to the school grade required to read a text with that score
+type gradeanchor struct {
+	score, grade float32
+}
+
+// The anchors of each formula, ordered by score. Scores in between are interpolated linearly,
+// scores beyond the first or last anchor are mapped to the grade of that anchor.
+// Formulas which already yield a grade map onto themselves.
+var gradeanchors = map[CompareType][]gradeanchor{
+	// the Wiener Sachtextformel ranges from 4: very easy to 15: very hard and approximates the grade
+	WSTF1: {{1, 1}, {18, 18}},
+	WSTF2: {{1, 1}, {18, 18}},
+	WSTF3: {{1, 1}, {18, 18}},
+	WSTF4: {{1, 1}, {18, 18}},
+	// Flesch's table: 90-100 grade 5, 80-90 grade 6, 70-80 grade 7, 60-70 grades 8-9, 50-60 grades 10-12, 30-50 college
+	FleschAmstad:      {{0, 18}, {30, 14}, {50, 11}, {60, 9}, {70, 7}, {80, 6}, {90, 5}, {100, 4}},
+	FleschReadingEase: {{0, 18}, {30, 14}, {50, 11}, {60, 9}, {70, 7}, {80, 6}, {90, 5}, {100, 4}},
+	KandelMoles:       {{0, 18}, {30, 14}, {50, 11}, {60, 9}, {70, 7}, {80, 6}, {90, 5}, {100, 4}},
+	FleschDouma:       {{0, 18}, {30, 14}, {50, 11}, {60, 9}, {70, 7}, {80, 6}, {90, 5}, {100, 4}},
+	// the INFLESZ scale: above 80 primary school, 65-80 lower secondary, 55-65 secondary, 40-55 upper secondary
+	SzigrisztPazos: {{0, 17}, {40, 12}, {55, 10}, {65, 8}, {80, 6}, {100, 4}},
+	// below 80 hard for primary school, below 60 for middle school, below 40 for high school readers
+	Gulpease:      {{0, 18}, {40, 13}, {60, 8}, {80, 5}, {100, 3}},
+	FleschKincaid: {{1, 1}, {18, 18}},
+	GunningFog:    {{1, 1}, {18, 18}},
+	SMOG:          {{1, 1}, {18, 18}},
+	ColemanLiau:   {{1, 1}, {18, 18}},
+	ARI:           {{1, 1}, {18, 18}},
+	// below 30 children's books, 30-40 fiction, 40-50 newspapers, 50-60 official texts, above 60 technical literature
+	LIX: {{20, 3}, {30, 5}, {40, 8}, {50, 11}, {60, 13}, {70, 17}},
+	// Anderson's conversion table, 7.2 and above is college level
+	RIX: {{0.2, 1}, {0.5, 2}, {0.8, 3}, {1.3, 4}, {1.8, 5}, {2.4, 6}, {3.0, 7}, {3.7, 8}, {4.5, 9}, {5.3, 10}, {6.2, 11}, {7.2, 12}, {10, 16}},
+	// the HIX ranges from 0: very hard to 20: very easy
+	HIX: {{0, 18}, {4, 15}, {8, 12}, {12, 9}, {16, 6}, {20, 4}},
+}
+
+// the highest grade of each band, ordered from VeryEasy to Hard
+var bandgrades = []int{5, 7, 10, 12}
+
+// labels and audiences of the bands by language, indexed by DifficultyBand
+var bandlabels = map[string][]string{
+	"de": {"", "sehr leicht", "leicht", "mittel", "schwer", "sehr schwer"},
+	"en": {"", "very easy", "easy", "medium", "hard", "very hard"},
+}
+
+var bandaudiences = map[string][]string{
+	"de": {"",
+		"Leseanfänger, Volksschule und Leser Leichter Sprache",
+		"Unterstufe und breite Öffentlichkeit",
+		"durchschnittliche erwachsene Leser, etwa von Tageszeitungen",
+		"Leser mit Matura oder Abitur",
+		"Akademiker und Fachleute"},
+	"en": {"",
+		"beginning readers, primary school and readers of plain language",
+		"lower secondary school and the general public",
+		"average adult readers, e.g. of daily newspapers",
+		"readers with a secondary school leaving certificate",
+		"graduates and specialists"},
+}
+
+// Label returns the name of the band in german for german language tags, in english otherwise
+func (b DifficultyBand) Label(lang string) string {
+	if b < VeryEasy || b > VeryHard {
+		return ""
+	}
+	return bandlabels[labellanguage(lang)][b]
+}
+
+// Audience describes the readers a text of the band is suited for, in german for german language tags, in english otherwise
+func (b DifficultyBand) Audience(lang string) string {
+	if b < VeryEasy || b > VeryHard {
+		return ""
+	}
+	return bandaudiences[labellanguage(lang)][b]
+}
+
+// labellanguage returns the language labels are available in for lang
+func labellanguage(lang string) string {
+	if baselanguage(lang) == "de" {
+		return "de"
+	}
+	return "en"
+}
+
+// Interpret maps the score of compare_type to a difficulty band, an approximate school grade and the audience the text
+// is suited for. The labels are german for german language tags like de-AT and english otherwise.
+// The mapping is a rough guide, the formulas were calibrated on different texts and school systems.
+func Interpret(compare_type CompareType, score float32, lang string) (*Interpretation, error) {
+
+	anchors, ok := gradeanchors[compare_type]
+	if !ok {
+		return nil, newerror(ErrUnknownCompareType, "Unknown compare type provided to Interpret: %d", compare_type)
+	}
+
+	// interpolate between the anchors surrounding score
+	grade := anchors[0].grade
+	for i, a := range anchors {
+		if score >= a.score {
+			grade = a.grade
+			if i+1 < len(anchors) && score < anchors[i+1].score {
+				next := anchors[i+1]
+				grade += (score - a.score) / (next.score - a.score) * (next.grade - a.grade)
+			}
+		}
+	}
+
+	interpretation := Interpretation{Band: VeryHard, Grade: int(math.Round(float64(grade)))}
+	for i, maxgrade := range bandgrades {
+		if interpretation.Grade <= maxgrade {
+			interpretation.Band = DifficultyBand(i + 1)
+			break
+		}
+	}
+	interpretation.Label = interpretation.Band.Label(lang)
+	interpretation.Audience = interpretation.Band.Audience(lang)
+	return &interpretation, nil
+}
+
+// Interpret maps the score of compare_type like the package function Interpret, labelled in the language of the engine
+func (r *Readability) Interpret(compare_type CompareType, score float32) (*Interpretation, error) {
+	return Interpret(compare_type, score, r.lang)
+}
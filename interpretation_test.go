@@ -0,0 +1,87 @@
+package readability
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInterpret(t *testing.T) {
+	tests := []struct {
+		compare_type CompareType
+		score        float32
+		grade        int
+		band         DifficultyBand
+	}{
+		// formulas yielding a grade map onto themselves, the bands end at grades 5, 7, 10 and 12
+		{ARI, 5.49, 5, VeryEasy},
+		{ARI, 5.5, 6, Easy},
+		{ARI, 7, 7, Easy},
+		{ARI, 8, 8, Medium},
+		{WSTF1, 10.2, 10, Medium},
+		{WSTF4, 11, 11, Hard},
+		{FleschKincaid, 12, 12, Hard},
+		{SMOG, 13, 13, VeryHard},
+		// beyond the first or last anchor
+		{ARI, -3, 1, VeryEasy},
+		{ARI, 25, 18, VeryHard},
+		{FleschReadingEase, 120, 4, VeryEasy},
+		{FleschAmstad, -10, 18, VeryHard},
+		// interpolated between anchors
+		{FleschReadingEase, 65, 8, Medium},
+		{FleschAmstad, 75, 7, Easy},
+		{FleschReadingEase, 60, 9, Medium},
+		{LIX, 35, 7, Easy},
+		{RIX, 4.1, 9, Medium},
+		{HIX, 10, 11, Hard},
+		{Gulpease, 50, 11, Hard},
+		{SzigrisztPazos, 72.5, 7, Easy},
+	}
+	for _, test := range tests {
+		interpretation, err := Interpret(test.compare_type, test.score, "en")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if interpretation.Grade != test.grade || interpretation.Band != test.band {
+			t.Errorf("%s %g: expected grade %d in band %d, got %+v", test.compare_type, test.score, test.grade, test.band, interpretation)
+		}
+	}
+
+	if _, err := Interpret(CompareType(99), 10, "de"); !errors.Is(err, ErrUnknownCompareType) {
+		t.Errorf("expected ErrUnknownCompareType, got %v", err)
+	}
+	for compare_type := range comparetypenames {
+		if _, err := Interpret(compare_type, 10, "de"); err != nil {
+			t.Errorf("%s: %v", compare_type, err)
+		}
+	}
+}
+
+func TestInterpretationLabels(t *testing.T) {
+	for lang, label := range map[string]string{"de": "mittel", "de-AT": "mittel", "en": "medium", "fr": "medium"} {
+		interpretation, err := Interpret(ARI, 9, lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if interpretation.Label != label || interpretation.Audience != Medium.Audience(lang) {
+			t.Errorf("%s: unexpected %+v", lang, interpretation)
+		}
+	}
+
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	interpretation, err := r.Interpret(WSTF1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interpretation.Label != "sehr leicht" {
+		t.Errorf("unexpected label %q", interpretation.Label)
+	}
+
+	for _, band := range []DifficultyBand{0, VeryHard + 1} {
+		if band.Label("de") != "" || band.Audience("en") != "" {
+			t.Errorf("expected no label for band %d", band)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package readability
+
+// Computes the Gulpease index for italian text:
+// GULP = 89 + (300 * sentences - 10 * letters) / words
+// cf. https://it.wikipedia.org/wiki/Indice_Gulpease
+func (ts *TextStatistics) Gulpease() (float32, error) {
+
+	if baselanguage(ts.Lang) != "it" {
+		return 0, newerror(ErrUnsupportedLanguage, "Gulpease operates only on italian text")
+	}
+
+	if err := ts.checkwords("Gulpease"); err != nil {
+		return 0, err
+	}
+
+	return 89 + (300*float32(ts.Sentences)-10*float32(ts.Letters))/float32(ts.Words), nil
+}
@@ -0,0 +1,79 @@
+package readability
+
+import "strings"
+
+// languagevariant is a regional variant of a language in initalisationfilenames.
+// It shares the resources of its base language, adapted by the fields below.
+type languagevariant struct {
+	base string
+	// added to the Punkt abbreviation types of the base language
+	abbreviations []string
+	// added to the Punkt collocations of the base language
+	collocations []string
+	// applied to words before they are hyphenated
+	normalize func(string) string
+}
+
+var languagevariants = map[string]languagevariant{
+	// austrian month names
+	"de-AT": languagevariant{
+		base:          "de",
+		abbreviations: []string{"jän", "feb"},
+		collocations:  []string{"##number##,jänner", "##number##,feber"},
+	},
+	// swiss orthography has no ß
+	"de-CH": languagevariant{
+		base:      "de",
+		normalize: strings.NewReplacer("ß", "ss").Replace,
+	},
+}
+
+// ResolveLanguage canonicalizes a BCP-47 language tag like "de-at" or "de_AT" to "de-AT" and resolves it to a language
+// a Readability Engine can be initialized for. Tags without dedicated support fall back to their language and region,
+// dropping script, variant and extension subtags, then to their language, e.g. "de-Latn-CH-1996" resolves to "de-CH"
+// and "de-DE" to "de".
+func ResolveLanguage(tag string) (string, error) {
+
+	subtags := strings.Split(strings.Replace(strings.TrimSpace(tag), "_", "-", -1), "-")
+	var region string
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 2, len(subtag) == 3 && strings.Trim(subtag, "0123456789") == "":
+			// region, which follows the language or the script, further subtags of this length belong to extensions
+			subtags[i] = strings.ToUpper(subtag)
+			if i == 1 || i == 2 && len(subtags[1]) == 4 {
+				region = subtags[i]
+			}
+		case len(subtag) == 4:
+			// script
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+
+	candidates := []string{strings.Join(subtags, "-")}
+	if region != "" {
+		candidates = append(candidates, subtags[0]+"-"+region)
+	}
+	candidates = append(candidates, subtags[0])
+	for _, lang := range candidates {
+		if _, ok := initalisationfilenames[lang]; ok {
+			return lang, nil
+		}
+		if _, ok := languagevariants[lang]; ok {
+			return lang, nil
+		}
+	}
+	return "", newerror(ErrUnsupportedLanguage, "unsupported language %s", tag)
+}
+
+// baselanguage returns the primary language subtag of a resolved language, e.g. "de" for "de-AT"
+func baselanguage(lang string) string {
+	if i := strings.Index(lang, "-"); i >= 0 {
+		return lang[:i]
+	}
+	return lang
+}
@@ -0,0 +1,46 @@
+package readability
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolveLanguage(t *testing.T) {
+	tests := []struct {
+		tag, want string
+	}{
+		{"de", "de"},
+		{"DE", "de"},
+		{" en ", "en"},
+		{"de-at", "de-AT"},
+		{"de_AT", "de-AT"},
+		{"de-CH", "de-CH"},
+		{"de-DE", "de"},
+		{"en-US", "en"},
+		{"de-Latn", "de"},
+		{"de-Latn-CH", "de-CH"},
+		{"de-latn-ch", "de-CH"},
+		{"de-CH-1996", "de-CH"},
+		{"de-Latn-CH-1996", "de-CH"},
+		{"de-AT-x-ch", "de-AT"},
+		{"de-1996", "de"},
+		{"es-419", "es"},
+		{"fr-CA", "fr"},
+	}
+	for _, test := range tests {
+		got, err := ResolveLanguage(test.tag)
+		if err != nil {
+			t.Errorf("%q: %v", test.tag, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%q resolved to %q, expected %q", test.tag, got, test.want)
+		}
+	}
+
+	for _, tag := range []string{"", "xx", "xx-DE", "ch-DE"} {
+		if got, err := ResolveLanguage(tag); !errors.Is(err, ErrUnsupportedLanguage) {
+			t.Errorf("%q: expected ErrUnsupportedLanguage, got %q, %v", tag, got, err)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package readability
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// block quote markers "> " of any depth
+	markdownquote = regexp.MustCompile(`^\s*(>\s?)+`)
+	// thematic breaks like "---", "***" or "___" and setext heading underlines like "==="
+	markdownrule = regexp.MustCompile(`^\s*(([-*_])\s*){3,}$|^\s*=+\s*$`)
+	// link reference definitions like "[1]: http://example.com"
+	markdownreference = regexp.MustCompile(`^\s*\[[^\]]+\]:\s*\S+`)
+	markdownfence     = regexp.MustCompile("^\\s*(```|~~~)")
+)
+
+// extractmarkdown removes markdown markup. Headings, list items, block quotes and paragraphs separated
+// by blank lines are blocks, fenced code blocks and the URLs of links and images are left out.
+func extractmarkdown(text string) *Document {
+	b := newdocumentbuilder(text)
+	var fenced bool
+
+	for offset := 0; offset < len(text); {
+		lineend := len(text)
+		if i := strings.IndexByte(text[offset:], '\n'); i >= 0 {
+			lineend = offset + i
+		}
+		line := text[offset:lineend]
+
+		switch {
+		case markdownfence.MatchString(line):
+			b.endblock(offset)
+			fenced = !fenced
+		case fenced:
+		case strings.TrimSpace(line) == "", markdownrule.MatchString(line):
+			b.endblock(offset)
+		case markdownreference.MatchString(line):
+		default:
+			content := offset
+			if m := markdownquote.FindStringIndex(line); m != nil {
+				content += m[1]
+			}
+			heading := headingmarker.FindStringIndex(text[content:lineend])
+			if heading != nil {
+				b.endblock(offset)
+				content += heading[1]
+			}
+			if m := listmarker.FindStringIndex(text[content:lineend]); m != nil {
+				b.endblock(offset)
+				content += m[1]
+			}
+
+			end := lineend
+			if heading != nil {
+				// closing sequence of an ATX heading
+				end = content + len(strings.TrimRight(text[content:lineend], "# \t\r"))
+			}
+			extractmarkdowninline(b, content, text[:end])
+			if lineend < len(text) {
+				b.copy(lineend, lineend+1)
+			}
+			if heading != nil {
+				b.endblock(lineend)
+			}
+		}
+		offset = lineend + 1
+	}
+	return b.document()
+}
+
+// extractmarkdowninline copies the text of a line from start to the end of text, leaving out emphasis,
+// code spans markers, inline html, URLs and the destinations of links and images
+func extractmarkdowninline(b *documentbuilder, start int, text string) {
+
+	for i := start; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && strings.IndexByte("\\`*_{}[]()#+-.!<>~|", text[i+1]) >= 0:
+			b.copy(i+1, i+2)
+			i += 2
+
+		case c == '[' || c == '!' && i+1 < len(text) && text[i+1] == '[':
+			if c == '!' {
+				i++
+			}
+			labelend := strings.IndexByte(text[i:], ']')
+			if labelend < 0 {
+				b.copy(i, len(text))
+				return
+			}
+			extractmarkdowninline(b, i+1, text[:i+labelend])
+			i += labelend + 1
+
+			// skip the destination of inline links and the label of reference links
+			if i < len(text) && (text[i] == '(' || text[i] == '[') {
+				closing := map[byte]byte{'(': ')', '[': ']'}[text[i]]
+				if end := strings.IndexByte(text[i:], closing); end >= 0 {
+					i += end + 1
+				}
+			}
+
+		case c == '<':
+			end := strings.IndexByte(text[i:], '>')
+			if end < 0 || i+1 == len(text) || !(isasciiletter(text[i+1]) || text[i+1] == '/' || text[i+1] == '!') {
+				b.copy(i, i+1)
+				i++
+				continue
+			}
+			i += end + 1
+
+		case c == '*' || c == '`' || c == '~':
+			i++
+
+		case c == '_' && (i == start || !iswordbyte(text[i-1]) || i+1 == len(text) || !iswordbyte(text[i+1])):
+			i++
+
+		case (strings.HasPrefix(text[i:], "http://") || strings.HasPrefix(text[i:], "https://")) && (i == start || text[i-1] == ' ' || text[i-1] == '\t'):
+			end := strings.IndexAny(text[i:], " \t")
+			if end < 0 {
+				return
+			}
+			i += end
+
+		default:
+			b.copy(i, i+1)
+			i++
+		}
+	}
+}
+
+// iswordbyte reports whether c is an ASCII letter or digit or part of a multi byte character
+func iswordbyte(c byte) bool {
+	return isasciiletter(c) || c >= '0' && c <= '9' || c >= 0x80
+}
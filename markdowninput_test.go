@@ -0,0 +1,19 @@
+package readability
+
+import "testing"
+
+func TestMarkdownOffsets(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"# Überschrift\n\nDer **Hund** bellt.\n", []string{"Überschrift", "Der", "Hund", "bellt"}},
+		{"- Größe _ändern_\n- [Köln](https://köln.de/ȺȺ) besuchen\n", []string{"Größe", "ändern", "Köln", "besuchen"}},
+		// skipped code blocks, link destinations and inline html with multi byte characters
+		{"```\nȺȺȺ ȺȺȺ\n```\nÜbel <span title=\"ȺȺ\">ist</span> es, siehe https://ȺȺ.example/ȺȺ dort.\n", []string{"Übel", "ist", "es", "siehe", "dort"}},
+		{"> Zitat mit \\*Stern\\* und `Ⱥ` Code.\n", []string{"Zitat", "mit", "Stern", "und", "Ⱥ", "Code"}},
+	}
+	for _, test := range tests {
+		checkdocumentwords(t, Markdown, test.text, test.want)
+	}
+}
@@ -0,0 +1,169 @@
+package readability
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// the XML content of an office document is read up to this many bytes, a small archive may expand far beyond its size
+const maxofficecontent = 64 * 1024 * 1024
+
+// officeformat describes where a ZIP plus XML office format keeps its text
+type officeformat struct {
+	// XML file within the archive holding the text
+	content string
+	// paragraph like elements, each is a block of text
+	blocks map[string]bool
+	// element holding the text, character data outside of it is ignored. If empty, all character data within blocks is text
+	text string
+	// elements whose content is not part of the text, like deleted text of tracked changes
+	skip map[string]bool
+	// text represented by an empty element like a tab
+	special func(xml.StartElement) string
+}
+
+var docx = officeformat{
+	content: "word/document.xml",
+	blocks:  map[string]bool{"p": true},
+	text:    "t",
+	special: docxspecial,
+}
+
+var odt = officeformat{
+	content: "content.xml",
+	blocks:  map[string]bool{"p": true, "h": true},
+	skip:    map[string]bool{"annotation": true, "tracked-changes": true, "note-citation": true},
+	special: odtspecial,
+}
+
+// ReadDOCX extracts the text of a Word document. Every paragraph, heading, list item and table cell is a block.
+func ReadDOCX(r io.ReaderAt, size int64) (*Document, error) {
+	return readofficeformats(r, size, docx)
+}
+
+// ReadODT extracts the text of an OpenDocument text document. Every paragraph, heading and list item is a block.
+func ReadODT(r io.ReaderAt, size int64) (*Document, error) {
+	return readofficeformats(r, size, odt)
+}
+
+// ReadOfficeDocument extracts the text of either a Word or an OpenDocument text document
+func ReadOfficeDocument(r io.ReaderAt, size int64) (*Document, error) {
+	return readofficeformats(r, size, docx, odt)
+}
+
+// readofficeformats extracts the text of a ZIP archive in the first of formats whose content it contains
+func readofficeformats(r io.ReaderAt, size int64, formats ...officeformat) (*Document, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, format := range formats {
+		for _, f := range archive.File {
+			if f.Name == format.content {
+				return readoffice(f, format)
+			}
+		}
+		names = append(names, format.content)
+	}
+	return nil, errors.New("document does not contain " + strings.Join(names, " or "))
+}
+
+// readoffice extracts the text of the XML content of an office document.
+// Returns ErrDocumentTooLarge if the content exceeds maxofficecontent bytes.
+func readoffice(content *zip.File, format officeformat) (*Document, error) {
+	if content.UncompressedSize64 > maxofficecontent {
+		return nil, newerror(ErrDocumentTooLarge, "%s of %d bytes exceeds the limit of %d bytes", content.Name, content.UncompressedSize64, maxofficecontent)
+	}
+	rc, err := content.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	// the size in the archive directory may understate the content, read one byte beyond the limit to notice
+	limited := &io.LimitedReader{R: rc, N: maxofficecontent + 1}
+	b := newdocumentbuilder("")
+	var inblock, intext, skipping int
+	decoder := xml.NewDecoder(limited)
+	for {
+		token, err := decoder.Token()
+		if limited.N == 0 {
+			return nil, newerror(ErrDocumentTooLarge, "%s exceeds the limit of %d bytes", content.Name, maxofficecontent)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch {
+			case skipping > 0 || format.skip[t.Name.Local]:
+				skipping++
+			case format.blocks[t.Name.Local]:
+				b.endblock(0)
+				inblock++
+			case t.Name.Local == format.text:
+				intext++
+			case inblock > 0:
+				b.replace(format.special(t), 0, 0)
+			}
+		case xml.EndElement:
+			switch {
+			case skipping > 0:
+				skipping--
+			case format.blocks[t.Name.Local]:
+				b.endblock(0)
+				inblock--
+			case t.Name.Local == format.text:
+				intext--
+			}
+		case xml.CharData:
+			if skipping == 0 && (intext > 0 || format.text == "" && inblock > 0) {
+				b.replace(string(t), 0, 0)
+			}
+		}
+	}
+	return b.textdocument(), nil
+}
+
+// docxspecial returns the text represented by an empty WordprocessingML element
+func docxspecial(e xml.StartElement) string {
+	switch e.Name.Local {
+	case "tab":
+		return "\t"
+	case "br", "cr":
+		return "\n"
+	}
+	return ""
+}
+
+// odtspecial returns the text represented by an empty OpenDocument element
+func odtspecial(e xml.StartElement) string {
+	switch e.Name.Local {
+	case "tab":
+		return "\t"
+	case "line-break":
+		return "\n"
+	case "s":
+		// a sequence of text:c spaces
+		spaces := 1
+		for _, a := range e.Attr {
+			if a.Name.Local == "c" {
+				if c, err := strconv.Atoi(a.Value); err == nil && c > 0 && c < 1000 {
+					spaces = c
+				}
+			}
+		}
+		return strings.Repeat(" ", spaces)
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package readability
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// officearchive returns a ZIP archive holding content as the file name
+func officearchive(t *testing.T, name, content string) *bytes.Reader {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadDOCX(t *testing.T) {
+	archive := officearchive(t, "word/document.xml", `<w:document xmlns:w="w"><w:body>`+
+		`<w:p><w:r><w:t>Der Hund</w:t><w:tab/><w:t>bellt.</w:t></w:r></w:p>`+
+		`<w:p><w:r><w:t>Die Katze schläft.</w:t></w:r></w:p></w:body></w:document>`)
+	d, err := ReadDOCX(archive, archive.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(d.Text, "Der Hund\tbellt.") || !strings.Contains(d.Text, "Die Katze schläft.") {
+		t.Errorf("unexpected text %q", d.Text)
+	}
+}
+
+func TestReadDOCXTooLarge(t *testing.T) {
+	archive := officearchive(t, "word/document.xml", "<w:document>"+strings.Repeat(" ", maxofficecontent)+"</w:document>")
+	if _, err := ReadDOCX(archive, archive.Size()); !errors.Is(err, ErrDocumentTooLarge) {
+		t.Errorf("expected ErrDocumentTooLarge, got %v", err)
+	}
+}
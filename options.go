@@ -0,0 +1,103 @@
+package readability
+
+import (
+	"io"
+	"strings"
+)
+
+// Option configures a Readability Engine on initialisation, overriding the language defaults
+type Option func(*options)
+
+type options struct {
+	training, hyphenpatterns io.Reader
+	shippedhyphenpatterns    string
+	lefthyphenmin            int
+	righthyphenmin           int
+	abbreviations            []string
+	hyphenationexceptions    []io.Reader
+	structured               bool
+	sentencesplitter         SentenceSplitter
+	wordsplitter             WordSplitter
+	newsyllablecounter       func(HyphenateFunc) SyllableCounter
+}
+
+// WithSentenceTraining replaces the shipped Punkt sentence training data by training data in JSON format read from r
+func WithSentenceTraining(r io.Reader) Option {
+	return func(o *options) {
+		o.training = r
+	}
+}
+
+// WithHyphenationPatterns replaces the shipped hyphenation patterns by TeX hyphenation patterns read from r
+func WithHyphenationPatterns(r io.Reader) Option {
+	return func(o *options) {
+		o.hyphenpatterns = r
+	}
+}
+
+// WithShippedHyphenationPatterns replaces the default hyphenation patterns of the language by other patterns
+// shipped with the package, e.g. hyph-de-1901 for the traditional german orthography or hyph-de-ch-1901 for swiss german.
+func WithShippedHyphenationPatterns(name string) Option {
+	return func(o *options) {
+		o.shippedhyphenpatterns = "data/hyphen/" + name + ".pat.txt"
+	}
+}
+
+// WithHyphenationExceptions reads hyphenated words like "geo-da-ten-por-tal" from r, which override the hyphenation
+// patterns. r may contain TeX \hyphenation{...} lists or simply words separated by white space. May be given repeatedly.
+func WithHyphenationExceptions(r io.Reader) Option {
+	return func(o *options) {
+		o.hyphenationexceptions = append(o.hyphenationexceptions, r)
+	}
+}
+
+// WithStructuredSegmentation uses the StructuredSentenceTokenizer, which additionally ends sentences at blank lines,
+// list items, headings and short lines. Use it for lists, titles and other texts lacking sentence punctuation.
+func WithStructuredSegmentation() Option {
+	return func(o *options) {
+		o.structured = true
+	}
+}
+
+// WithSentenceSplitter replaces the Punkt sentence tokenizer, the sentence training data is not used then
+func WithSentenceSplitter(s SentenceSplitter) Option {
+	return func(o *options) {
+		o.sentencesplitter = s
+	}
+}
+
+// WithWordSplitter replaces the splitting of sentences into words along the Unicode word boundaries
+func WithWordSplitter(w WordSplitter) Option {
+	return func(o *options) {
+		o.wordsplitter = w
+	}
+}
+
+// WithMinSyllableLength discards hyphenation points which would leave fewer than left characters at the start
+// or fewer than right characters at the end of a word, like TeX's \lefthyphenmin and \righthyphenmin.
+// By default all hyphenation points found by the patterns are used.
+func WithMinSyllableLength(left, right int) Option {
+	return func(o *options) {
+		o.lefthyphenmin, o.righthyphenmin = left, right
+	}
+}
+
+// WithAbbreviations adds abbreviations like "Abs." or "BGBl." to the sentence training data,
+// so the sentence tokenizer does not split sentences after them.
+func WithAbbreviations(abbreviations ...string) Option {
+	return func(o *options) {
+		o.abbreviations = append(o.abbreviations, abbreviations...)
+	}
+}
+
+// WithSyllableCounter replaces the syllable counter of the language, newcounter is called with the hyphenation of the engine
+func WithSyllableCounter(newcounter func(HyphenateFunc) SyllableCounter) Option {
+	return func(o *options) {
+		o.newsyllablecounter = newcounter
+	}
+}
+
+// abbreviationtype normalizes an abbreviation the way Punkt stores abbreviation types: lower case without the final period
+func abbreviationtype(abbreviation string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(abbreviation), "."))
+}
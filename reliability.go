@@ -0,0 +1,113 @@
+package readability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+const (
+	// number of words and sentences from which on a score is considered fully reliable
+	reliablewords     = 100
+	reliablesentences = 5
+	// number of resamples drawn to estimate a confidence interval
+	bootstrapsamples = 1000
+)
+
+// ConfidenceInterval is the range a readability score lies in with the probability Level
+type ConfidenceInterval struct {
+	Level float32 `description:"confidence level, e.g. 0.95"`
+	Lower float32 `description:"lower bound of the score"`
+	Upper float32 `description:"upper bound of the score"`
+}
+
+// Reliability rates how far scores computed from the statistics can be trusted, from 0: noise to 1: reliable.
+// The sampling error of averages like words per sentence shrinks with the square root of the sample size,
+// so the reliability is the square root of the share of reliablewords words and reliablesentences sentences,
+// whichever is lower. A title of five words in a single sentence rates 0.22.
+func (ts *TextStatistics) Reliability() float32 {
+	words := math.Sqrt(float64(ts.Words) / reliablewords)
+	sentences := math.Sqrt(float64(ts.Sentences) / reliablesentences)
+	return float32(math.Min(1, math.Min(words, sentences)))
+}
+
+// Bootstrap estimates a confidence interval at level, e.g. 0.95, of every score requested by resampling the sentences
+// of text. If no compare type is provided, all compare types supported by the engine are estimated.
+func (r *Readability) Bootstrap(text string, level float32, compare_types ...CompareType) (map[CompareType]ConfidenceInterval, error) {
+	return r.BootstrapDocumentContext(context.Background(), NewDocument(text, PlainText), level, compare_types...)
+}
+
+// BootstrapDocumentContext is Bootstrap for a Document, checking ctx before every sentence and resample.
+// The resamples are drawn from a fixed seed, so the intervals of a document do not change between calls.
+func (r *Readability) BootstrapDocumentContext(ctx context.Context, d *Document, level float32, compare_types ...CompareType) (map[CompareType]ConfidenceInterval, error) {
+
+	if !(level > 0 && level < 1) {
+		return nil, errors.New(fmt.Sprintf("confidence level %g is not between 0 and 1", level))
+	}
+	if len(compare_types) == 0 {
+		compare_types = r.CompareTypes()
+	}
+
+	if strings.TrimSpace(d.Text) == "" {
+		return nil, ErrEmptyText
+	}
+	ts := r.newtextstatistics()
+	var sentences []TextStatistics
+	for _, val := range d.sentences(r.sentencesplitter) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sts, err := r.analyzesentence(val.Text)
+		if err != nil {
+			return nil, err
+		}
+		if sts.Words > 0 {
+			ts.add(sts)
+			sentences = append(sentences, sts)
+		}
+	}
+	// fail early on compare types which do not apply to the text
+	for _, compare_type := range compare_types {
+		if _, err := ts.Score(compare_type); err != nil {
+			return nil, err
+		}
+	}
+
+	scores := make(map[CompareType][]float32, len(compare_types))
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < bootstrapsamples; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sample := r.newtextstatistics()
+		for range sentences {
+			sample.add(sentences[rnd.Intn(len(sentences))])
+		}
+		for _, compare_type := range compare_types {
+			score, err := sample.Score(compare_type)
+			if err != nil {
+				return nil, err
+			}
+			scores[compare_type] = append(scores[compare_type], score)
+		}
+	}
+
+	lower, upper := percentiles(level)
+	result := make(map[CompareType]ConfidenceInterval, len(compare_types))
+	for compare_type, s := range scores {
+		sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+		result[compare_type] = ConfidenceInterval{Level: level, Lower: s[lower], Upper: s[upper]}
+	}
+	return result, nil
+}
+
+// percentiles returns the indices of the sorted resampled scores which bound the interval at level
+func percentiles(level float32) (lower, upper int) {
+	// round, as 1 - level is not exact in float32, e.g. 0.99 would yield index 4 instead of 5
+	lower = int(math.Round(float64(1-level) / 2 * bootstrapsamples))
+	return lower, bootstrapsamples - 1 - lower
+}
@@ -0,0 +1,102 @@
+package readability
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestReliability(t *testing.T) {
+	tests := []struct {
+		words, sentences int
+		want             float32
+	}{
+		{0, 0, 0},
+		// a title, rated by its words
+		{5, 1, 0.22},
+		// few long sentences, rated by the sentences
+		{200, 2, 0.63},
+		{25, 5, 0.5},
+		{100, 5, 1},
+		{1000, 50, 1},
+	}
+	for _, test := range tests {
+		ts := TextStatistics{Words: test.words, Sentences: test.sentences}
+		if got := ts.Reliability(); math.Abs(float64(got-test.want)) > 0.005 {
+			t.Errorf("%d words in %d sentences: expected %g, got %g", test.words, test.sentences, test.want, got)
+		}
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	tests := []struct {
+		level        float32
+		lower, upper int
+	}{
+		{0.9, 50, 949},
+		{0.95, 25, 974},
+		{0.99, 5, 994},
+		{0.999, 0, 999},
+		{0.5, 250, 749},
+	}
+	for _, test := range tests {
+		if lower, upper := percentiles(test.level); lower != test.lower || upper != test.upper {
+			t.Errorf("level %g: expected %d-%d, got %d-%d", test.level, test.lower, test.upper, lower, upper)
+		}
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := "Der Hund bellt. Die Katze schläft auf dem Sofa. Der Gemeinderat beschloss nach langer Debatte das umfangreiche Budget. " +
+		"Es regnet. Die Verwaltungsgerichtsbarkeit überprüft die Rechtmäßigkeit behördlicher Entscheidungen. Wir gehen heim."
+
+	for _, level := range []float32{0, 1, -0.5, 95} {
+		if _, err := r.Bootstrap(text, level); err == nil {
+			t.Errorf("level %g: expected an error", level)
+		}
+	}
+	if _, err := r.Bootstrap(" ", 0.95); !errors.Is(err, ErrEmptyText) {
+		t.Errorf("expected ErrEmptyText, got %v", err)
+	}
+
+	intervals, err := r.Bootstrap(text, 0.95)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scores, err := r.Scores(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(intervals) != len(r.CompareTypes()) {
+		t.Errorf("expected an interval for all %d compare types, got %d", len(r.CompareTypes()), len(intervals))
+	}
+	narrow, err := r.Bootstrap(text, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for compare_type, ci := range intervals {
+		if ci.Level != 0.95 || ci.Lower > ci.Upper {
+			t.Errorf("%s: invalid interval %+v", compare_type, ci)
+		}
+		if score := scores[compare_type]; score < ci.Lower || score > ci.Upper {
+			t.Errorf("%s: score %g outside of %+v", compare_type, score, ci)
+		}
+		if n := narrow[compare_type]; n.Lower < ci.Lower || n.Upper > ci.Upper {
+			t.Errorf("%s: interval at level 0.5 %+v wider than %+v", compare_type, n, ci)
+		}
+	}
+
+	// the resamples are drawn from a fixed seed
+	again, err := r.Bootstrap(text, 0.95)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(intervals, again) {
+		t.Errorf("intervals differ between calls: %v, %v", intervals, again)
+	}
+}
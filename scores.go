@@ -0,0 +1,116 @@
+package readability
+
+import "fmt"
+
+// the compare types of a language which require syllable counts
+var comparetypes = map[string][]CompareType{
+	"de": []CompareType{WSTF1, WSTF2, WSTF3, WSTF4, FleschAmstad, HIX},
+	"en": []CompareType{FleschReadingEase, FleschKincaid, GunningFog, SMOG},
+	"fr": []CompareType{KandelMoles},
+	"es": []CompareType{SzigrisztPazos},
+	"nl": []CompareType{FleschDouma},
+}
+
+// the compare types of a language which require only letters, words and sentences
+var charactercomparetypes = map[string][]CompareType{
+	"it": []CompareType{Gulpease},
+}
+
+// the compare types which can be computed for every language
+var characterbasedcomparetypes = []CompareType{ColemanLiau, ARI, LIX, RIX}
+
+var comparetypenames = map[CompareType]string{
+	WSTF1: "WSTF1", WSTF2: "WSTF2", WSTF3: "WSTF3", WSTF4: "WSTF4",
+	FleschAmstad: "FleschAmstad", FleschReadingEase: "FleschReadingEase", FleschKincaid: "FleschKincaid",
+	GunningFog: "GunningFog", SMOG: "SMOG", ColemanLiau: "ColemanLiau", ARI: "ARI", LIX: "LIX", RIX: "RIX",
+	KandelMoles: "KandelMoles", SzigrisztPazos: "SzigrisztPazos", Gulpease: "Gulpease", FleschDouma: "FleschDouma",
+	HIX: "HIX",
+}
+
+// String returns the name of the compare type, e.g. WSTF1
+func (t CompareType) String() string {
+	if name, ok := comparetypenames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("CompareType(%d)", int(t))
+}
+
+// Returns the compare types which can be computed by this Readability engine
+func (r *Readability) CompareTypes() []CompareType {
+	var result []CompareType
+	if r.syllables != nil {
+		result = append(result, comparetypes[baselanguage(r.lang)]...)
+	}
+	result = append(result, charactercomparetypes[baselanguage(r.lang)]...)
+	return append(result, characterbasedcomparetypes...)
+}
+
+// Computes the readability formula given by compare type from previously gathered text statistics.
+func (ts *TextStatistics) Score(compare_type CompareType) (float32, error) {
+	switch compare_type {
+	case WSTF1, WSTF2, WSTF3, WSTF4:
+		return ts.WienerSachTextFormelType(compare_type)
+	case FleschAmstad:
+		return ts.FleschReadingEaseAmstad()
+	case FleschReadingEase:
+		return ts.FleschReadingEase()
+	case FleschKincaid:
+		return ts.FleschKincaidGrade()
+	case GunningFog:
+		return ts.GunningFog()
+	case SMOG:
+		return ts.SMOG()
+	case ColemanLiau:
+		return ts.ColemanLiau()
+	case ARI:
+		return ts.AutomatedReadabilityIndex()
+	case LIX:
+		return ts.LIX()
+	case RIX:
+		return ts.RIX()
+	case KandelMoles:
+		return ts.KandelMoles()
+	case SzigrisztPazos:
+		return ts.SzigrisztPazos()
+	case Gulpease:
+		return ts.Gulpease()
+	case FleschDouma:
+		return ts.FleschDouma()
+	case HIX:
+		return ts.HIX()
+	}
+	return 0, newerror(ErrUnknownCompareType, "Unknown compare type provided to Score: %d", compare_type)
+}
+
+// Score analyzes text and computes the readability formula given by compare type.
+func (r *Readability) Score(text string, compare_type CompareType) (float32, error) {
+	ts, err := r.Analyze(text)
+	if err != nil {
+		return 0, err
+	}
+	return ts.Score(compare_type)
+}
+
+// Scores analyzes text once and computes all requested readability formulas from the gathered statistics.
+// If no compare type is provided, all compare types supported by the engine are computed.
+func (r *Readability) Scores(text string, compare_types ...CompareType) (map[CompareType]float32, error) {
+
+	if len(compare_types) == 0 {
+		compare_types = r.CompareTypes()
+	}
+
+	ts, err := r.Analyze(text)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[CompareType]float32, len(compare_types))
+	for _, compare_type := range compare_types {
+		score, err := ts.Score(compare_type)
+		if err != nil {
+			return nil, err
+		}
+		scores[compare_type] = score
+	}
+	return scores, nil
+}
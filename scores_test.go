@@ -0,0 +1,63 @@
+package readability
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestScoreWithoutSyllables(t *testing.T) {
+	training, err := shippedresources.Open("data/german.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer training.Close()
+	r, err := NewReadabilityFromReader("de", training, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts, err := r.Analyze("Der Gemeinderat beschloss das Budget. Die Gemeinde prüft den Antrag.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ts.NoSyllables {
+		t.Error("expected NoSyllables for an engine without hyphenation patterns")
+	}
+	for _, compare_type := range []CompareType{WSTF1, WSTF4, FleschAmstad, HIX} {
+		if _, err := ts.Score(compare_type); !errors.Is(err, ErrNoSyllables) {
+			t.Errorf("%s: expected ErrNoSyllables, got %v", compare_type, err)
+		}
+	}
+	for _, compare_type := range r.CompareTypes() {
+		if _, err := ts.Score(compare_type); err != nil {
+			t.Errorf("%s: %v", compare_type, err)
+		}
+	}
+}
+
+func TestGulpeaseWithoutSyllables(t *testing.T) {
+	training, err := shippedresources.Open("data/italian.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer training.Close()
+	r, err := NewReadabilityFromReader("it", training, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found bool
+	for _, compare_type := range r.CompareTypes() {
+		found = found || compare_type == Gulpease
+	}
+	if !found {
+		t.Errorf("Gulpease missing from %v", r.CompareTypes())
+	}
+	scores, err := r.Scores("Il cane abbaia. La gatta dorme sul divano.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := scores[Gulpease]; !ok {
+		t.Errorf("Gulpease missing from %v", scores)
+	}
+}
@@ -0,0 +1,70 @@
+package readability
+
+import (
+	"context"
+	"sort"
+)
+
+// SentenceStatistics holds the readability breakdown of a single sentence
+type SentenceStatistics struct {
+	Start         int     `description:"byte offset of the sentence start within the analyzed text"`
+	End           int     `description:"byte offset of the sentence end within the analyzed text"`
+	Text          string  `description:"the sentence"`
+	Words         int     `description:"number of words"`
+	LongWords     int     `description:"number of words longer than six characters"`
+	Polysyllables int     `description:"number of words with three or more syllables"`
+	Readability   float32 `description:"Wiener Sachtextformel of the sentence"`
+}
+
+// AnalyzeSentences computes the Wiener Sachtextformel for every sentence of text.
+// The sentences are ranked by their score, the hardest to read sentence first.
+// Sentences which do not contain any word are omitted.
+func (r *Readability) AnalyzeSentences(text string, WSTF_Type CompareType) ([]SentenceStatistics, error) {
+	return r.AnalyzeDocumentSentences(NewDocument(text, PlainText), WSTF_Type)
+}
+
+// AnalyzeDocumentSentences computes the Wiener Sachtextformel for every sentence of a Document like AnalyzeSentences.
+// Start and End are offsets within the original text, Text is the sentence without markup.
+func (r *Readability) AnalyzeDocumentSentences(d *Document, WSTF_Type CompareType) ([]SentenceStatistics, error) {
+	return r.AnalyzeDocumentSentencesContext(context.Background(), d, WSTF_Type)
+}
+
+// AnalyzeDocumentSentencesContext is AnalyzeDocumentSentences, checking ctx before every sentence.
+func (r *Readability) AnalyzeDocumentSentencesContext(ctx context.Context, d *Document, WSTF_Type CompareType) ([]SentenceStatistics, error) {
+
+	if !(WSTF_Type == WSTF1 || WSTF_Type == WSTF2 || WSTF_Type == WSTF3 || WSTF_Type == WSTF4) {
+		return nil, newerror(ErrUnknownCompareType, "Unknown compare type provided to AnalyzeSentences: %d", WSTF_Type)
+	}
+
+	var result []SentenceStatistics
+	for _, val := range d.sentences(r.sentencesplitter) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		ts, err := r.analyzesentence(val.Text)
+		if err != nil {
+			return nil, err
+		}
+		if ts.Words == 0 {
+			continue
+		}
+
+		wstf, err := ts.WienerSachTextFormelType(WSTF_Type)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, SentenceStatistics{
+			Start:         d.OriginalOffset(val.Start),
+			End:           d.originalend(val.End),
+			Text:          val.Text,
+			Words:         ts.Words,
+			LongWords:     ts.LongWords,
+			Polysyllables: ts.Polysyllables,
+			Readability:   wstf,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Readability > result[j].Readability })
+	return result, nil
+}
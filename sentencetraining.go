@@ -0,0 +1,245 @@
+package readability
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/neurosnap/sentences"
+)
+
+// thresholds of the Punkt algorithm (Kiss & Strunk 2006), the defaults of the NLTK Punkt trainer
+const (
+	punktabbreviation  = 0.3
+	punktsentstarter   = 30
+	punktcollocation   = 7.88
+	punktmincollocfreq = 1
+)
+
+// orthographic context flags as used by the sentence tokenizer
+var punktortho = map[[2]string]int{
+	{"initial", "upper"}:  1 << 1,
+	{"internal", "upper"}: 1 << 2,
+	{"unknown", "upper"}:  1 << 3,
+	{"initial", "lower"}:  1 << 4,
+	{"internal", "lower"}: 1 << 5,
+	{"unknown", "lower"}:  1 << 6,
+}
+
+var punktnonpunct = regexp.MustCompile(sentences.NewPunctStrings().NonPunct())
+
+// SentenceTrainer learns Punkt sentence training data, i.e. abbreviations, collocations, sentence starters
+// and the orthographic context of words, from plain text. It is a simplified port of the NLTK Punkt trainer.
+// The result can be encoded as JSON and loaded by NewReadability using WithSentenceTraining.
+type SentenceTrainer struct {
+	words  *sentences.DefaultWordTokenizer
+	tokens []*sentences.Token
+	// frequency of every word type, types of period final words keep their period
+	types map[string]int
+	// number of period final words
+	periods int
+}
+
+// NewSentenceTrainer returns a SentenceTrainer without any text
+func NewSentenceTrainer() *SentenceTrainer {
+	return &SentenceTrainer{words: sentences.NewWordTokenizer(sentences.NewPunctStrings()), types: make(map[string]int)}
+}
+
+// Train adds the plain text read from r to the training corpus. Every text starts a new paragraph.
+func (t *SentenceTrainer) Train(r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	text := strings.TrimSpace(string(b))
+	if text == "" {
+		return nil
+	}
+
+	// the word tokenizer only emits words followed by white space
+	tokens := t.words.Tokenize(text+"\n", false)
+	tokens[0].ParaStart = true
+	for _, tok := range tokens {
+		t.types[t.words.Type(tok)]++
+		if t.words.HasPeriodFinal(tok) {
+			t.periods++
+		}
+	}
+	t.tokens = append(t.tokens, tokens...)
+	return nil
+}
+
+// Storage returns the sentence training data learned from the texts given to Train.
+// abbreviations like "Abs." or "BGBl." are added to the abbreviations found in the texts.
+func (t *SentenceTrainer) Storage(abbreviations ...string) *sentences.Storage {
+	storage := sentences.NewStorage()
+	n := float64(len(t.tokens))
+
+	// abbreviations are frequent with a final period, rare without, short and may contain internal periods
+	for typ, withperiod := range t.types {
+		if !strings.HasSuffix(typ, ".") || len(typ) < 2 {
+			continue
+		}
+		typ = typ[:len(typ)-1]
+		if !punktnonpunct.MatchString(typ) || strings.HasPrefix(typ, "##number##") {
+			continue
+		}
+
+		withoutperiod := t.types[typ]
+		periods := strings.Count(typ, ".") + 1
+		nonperiods := len([]rune(typ)) - periods + 1
+
+		ll := dunningloglikelihood(float64(withperiod+withoutperiod), float64(t.periods), float64(withperiod), n)
+		score := ll * math.Exp(-float64(nonperiods)) * float64(periods) * math.Pow(float64(nonperiods), -float64(withoutperiod))
+		if score >= punktabbreviation {
+			storage.AbbrevTypes.Add(typ)
+		}
+	}
+	for _, abbreviation := range abbreviations {
+		storage.AbbrevTypes.Add(abbreviationtype(abbreviation))
+	}
+
+	t.annotate(storage)
+	t.orthographiccontext(storage)
+
+	// count the words following a sentence break and the pairs of words around an abbreviation-like break
+	var sentbreaks int
+	starters := make(map[string]int)
+	collocations := make(map[[2]string]int)
+	for i, tok := range t.tokens {
+		if !tok.SentBreak {
+			continue
+		}
+		sentbreaks++
+		if i+1 == len(t.tokens) {
+			continue
+		}
+		next := t.tokens[i+1]
+		first, second := t.words.TypeNoPeriod(tok), t.words.TypeNoSentPeriod(next)
+
+		// breaks after ordinal numbers and initials are unreliable, their followers are collocation candidates
+		if strings.HasPrefix(first, "##number##") || t.words.IsInitial(tok) {
+			if punktnonpunct.MatchString(second) {
+				collocations[[2]string{first, second}]++
+			}
+			continue
+		}
+		starters[second]++
+	}
+
+	// sentence starters follow sentence breaks much more often than expected
+	for typ, atbreak := range starters {
+		if typ == "" || sentbreaks == 0 {
+			continue
+		}
+		count := t.types[typ] + t.types[typ+"."]
+		ll := collocationloglikelihood(float64(sentbreaks), float64(count), float64(atbreak), n)
+		if ll >= punktsentstarter && n/float64(sentbreaks) > float64(count)/float64(atbreak) {
+			storage.SentStarters.Add(typ)
+		}
+	}
+
+	// collocations are pairs like "3. Oktober" whose first word ends with a period but does not end the sentence
+	for pair, count := range collocations {
+		if storage.SentStarters.Has(pair[0]) || storage.SentStarters.Has(pair[1]) {
+			continue
+		}
+		count1 := t.types[pair[0]] + t.types[pair[0]+"."]
+		count2 := t.types[pair[1]] + t.types[pair[1]+"."]
+		if count1 <= 1 || count2 <= 1 || count <= punktmincollocfreq || count > count1 || count > count2 {
+			continue
+		}
+		ll := collocationloglikelihood(float64(count1), float64(count2), float64(count), n)
+		if ll >= punktcollocation && n/float64(count1) > float64(count2)/float64(count) {
+			storage.Collocations.Add(pair[0] + "," + pair[1])
+		}
+	}
+
+	return storage
+}
+
+// annotate marks sentence breaks and abbreviations using the abbreviations of storage
+func (t *SentenceTrainer) annotate(storage *sentences.Storage) {
+	for _, tok := range t.tokens {
+		tok.SentBreak, tok.Abbr = false, false
+		switch {
+		case t.words.HasSentEndChars(tok):
+			tok.SentBreak = true
+		case t.words.IsEllipsis(tok):
+		case t.words.HasPeriodFinal(tok):
+			typ := strings.ToLower(strings.TrimSuffix(tok.Tok, "."))
+			parts := strings.Split(typ, "-")
+			if storage.AbbrevTypes.Has(typ) || storage.AbbrevTypes.Has(parts[len(parts)-1]) {
+				tok.Abbr = true
+			} else {
+				tok.SentBreak = true
+			}
+		}
+	}
+}
+
+// orthographiccontext records for every word type whether it occurs upper or lower case
+// at the beginning, within or at an unknown position of a sentence
+func (t *SentenceTrainer) orthographiccontext(storage *sentences.Storage) {
+	context := "internal"
+	for _, tok := range t.tokens {
+		if tok.ParaStart && context != "unknown" {
+			context = "initial"
+		}
+		if tok.LineStart && context == "internal" {
+			context = "unknown"
+		}
+
+		var firstcase string
+		switch {
+		case t.words.FirstUpper(tok):
+			firstcase = "upper"
+		case t.words.FirstLower(tok):
+			firstcase = "lower"
+		}
+		if flag := punktortho[[2]string{context, firstcase}]; flag != 0 {
+			storage.OrthoContext[t.words.TypeNoSentPeriod(tok)] |= flag
+		}
+
+		switch {
+		case tok.SentBreak && (strings.HasPrefix(t.words.Type(tok), "##number##") || t.words.IsInitial(tok)):
+			context = "unknown"
+		case tok.SentBreak:
+			context = "initial"
+		case tok.Abbr || t.words.IsEllipsis(tok):
+			context = "unknown"
+		default:
+			context = "internal"
+		}
+	}
+}
+
+// dunningloglikelihood is the log likelihood ratio of a word occurring count_ab times with a final period
+// among its count_a occurrences, given count_b of n words end with a period
+func dunningloglikelihood(counta, countb, countab, n float64) float64 {
+	p1 := countb / n
+	p2 := 0.99
+	null := countab*math.Log(p1) + (counta-countab)*math.Log(1-p1)
+	alt := countab*math.Log(p2) + (counta-countab)*math.Log(1-p2)
+	return -2 * (null - alt)
+}
+
+// collocationloglikelihood is the log likelihood ratio of words a and b occurring count_ab times together
+func collocationloglikelihood(counta, countb, countab, n float64) float64 {
+	p := countb / n
+	p1 := countab / counta
+	p2 := (countb - countab) / (n - counta)
+
+	summand1 := countab*math.Log(p) + (counta-countab)*math.Log(1-p)
+	summand2 := (countb-countab)*math.Log(p) + (n-counta-countb+countab)*math.Log(1-p)
+	var summand3, summand4 float64
+	if counta != countab {
+		summand3 = countab*math.Log(p1) + (counta-countab)*math.Log(1-p1)
+	}
+	if countb != countab {
+		summand4 = (countb-countab)*math.Log(p2) + (n-counta-countb+countab)*math.Log(1-p2)
+	}
+	return -2 * (summand1 + summand2 - summand3 - summand4)
+}
@@ -0,0 +1,75 @@
+package readability
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// legal texts abbreviate "gemäß", "Absatz" and "Bundesgesetzblatt" in almost every sentence
+var sentencetrainingcorpus = []string{
+	"Der Antrag ist gem. Abs. 2 schriftlich zu stellen. Die Frist beträgt gem. Abs. 3 vier Wochen.",
+	"Das Gesetz wurde im BGBl. I Nr. 12 verkündet. Die Novelle wurde im BGBl. I Nr. 40 kundgemacht.",
+	"Die Behörde entscheidet gem. Abs. 1 ohne Verzug. Gegen den Bescheid ist gem. Abs. 4 eine Beschwerde zulässig.",
+	"Die Verordnung ist im BGBl. II Nr. 7 veröffentlicht. Sie tritt gem. Abs. 5 mit dem Folgetag in Kraft.",
+	"Die Gemeinde hat gem. Abs. 6 einen Bericht zu erstatten. Der Bericht ist gem. Abs. 7 im BGBl. III kundzumachen.",
+}
+
+func TestSentenceTrainer(t *testing.T) {
+	text := "Die Frist beginnt gem. Abs. 2 mit der Zustellung. Das Gesetz wurde im BGBl. I Nr. 12 verkündet. Der Antrag ist gem. Abs. 3 einzubringen."
+
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := r.Analyze(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.Sentences != 6 {
+		t.Errorf("expected the shipped training data to split 6 sentences, got %d", ts.Sentences)
+	}
+
+	trainer := NewSentenceTrainer()
+	for _, text := range sentencetrainingcorpus {
+		if err := trainer.Train(strings.NewReader(text)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// "gem." and "Abs." are learned from the corpus, "BGBl." is too long for its few occurrences and given manually
+	if storage := trainer.Storage(); !storage.AbbrevTypes.Has("gem") || !storage.AbbrevTypes.Has("abs") || storage.AbbrevTypes.Has("bgbl") {
+		t.Errorf("unexpected abbreviations %v", storage.AbbrevTypes)
+	}
+	storage := trainer.Storage("BGBl.")
+	if !storage.AbbrevTypes.Has("bgbl") {
+		t.Errorf("abbreviation BGBl. not added to %v", storage.AbbrevTypes)
+	}
+
+	var b bytes.Buffer
+	if err := json.NewEncoder(&b).Encode(storage); err != nil {
+		t.Fatal(err)
+	}
+	r, err = NewReadability("de", WithSentenceTraining(&b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err = r.Analyze(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.Sentences != 3 {
+		t.Errorf("expected the trained data to split 3 sentences, got %d", ts.Sentences)
+	}
+}
+
+func TestSentenceTrainerEmptyText(t *testing.T) {
+	trainer := NewSentenceTrainer()
+	if err := trainer.Train(strings.NewReader(" \n\t")); err != nil {
+		t.Fatal(err)
+	}
+	if storage := trainer.Storage("Abs."); len(storage.AbbrevTypes) != 1 || !storage.AbbrevTypes.Has("abs") {
+		t.Errorf("unexpected abbreviations %v", storage.AbbrevTypes)
+	}
+}
@@ -0,0 +1,23 @@
+package readability
+
+// Computes the Índice de Perspicuidad by Szigriszt-Pazos for spanish text:
+// IPSZ = 206.835 - 62.3 * ASW - ASL
+// cf. https://legible.es/blog/perspicuidad-szigriszt-pazos/
+func (ts *TextStatistics) SzigrisztPazos() (float32, error) {
+
+	if baselanguage(ts.Lang) != "es" {
+		return 0, newerror(ErrUnsupportedLanguage, "SzigrisztPazos operates only on spanish text")
+	}
+
+	if err := ts.checkwords("SzigrisztPazos"); err != nil {
+		return 0, err
+	}
+	if err := ts.checksyllables("SzigrisztPazos"); err != nil {
+		return 0, err
+	}
+
+	var ASL = float32(ts.Words) / float32(ts.Sentences)
+	var ASW = float32(ts.Syllables) / float32(ts.Words)
+
+	return 206.835 - 62.3*ASW - ASL, nil
+}
@@ -0,0 +1,106 @@
+package readability
+
+import (
+	"github.com/blevesearch/segment"
+	"github.com/neurosnap/sentences"
+)
+
+// Sentence is a sentence of a text, Start and End are byte offsets within the text
+type Sentence struct {
+	Start int
+	End   int
+	Text  string
+}
+
+// SentenceSplitter splits a text into sentences in text order. Text between sentences is not analyzed.
+type SentenceSplitter interface {
+	Sentences(text string) []Sentence
+}
+
+// WordKind tells words from numbers
+type WordKind int
+
+const (
+	// Letters is a word, the readability formulas count its syllables and characters
+	Letters WordKind = iota
+	// Number is a number, only its characters are counted
+	Number
+)
+
+// Word is a word or number of a sentence, Start and End are byte offsets within the sentence
+type Word struct {
+	Start int
+	End   int
+	Text  string
+	Kind  WordKind
+}
+
+// WordSplitter splits a sentence into words and numbers in text order, leaving out punctuation and white space
+type WordSplitter interface {
+	Words(sentence string) ([]Word, error)
+}
+
+// blocksplitter is implemented by sentence splitters which split blocks of text independently of each other
+type blocksplitter interface {
+	// blockstart returns the start of the block of text containing offset
+	blockstart(text string, offset int) int
+}
+
+// PunktSentenceSplitter adapts a Punkt sentence tokenizer like the DefaultSentenceTokenizer
+// or the StructuredSentenceTokenizer to SentenceSplitter
+type PunktSentenceSplitter struct {
+	Tokenizer sentences.SentenceTokenizer
+}
+
+// NewPunktSentenceSplitter returns the default SentenceSplitter, used with the sentence training data of the language
+func NewPunktSentenceSplitter(tokenizer sentences.SentenceTokenizer) *PunktSentenceSplitter {
+	return &PunktSentenceSplitter{Tokenizer: tokenizer}
+}
+
+// Sentences splits text by the Punkt tokenizer
+func (p *PunktSentenceSplitter) Sentences(text string) []Sentence {
+	tokenized := p.Tokenizer.Tokenize(text)
+	result := make([]Sentence, 0, len(tokenized))
+	for _, s := range tokenized {
+		result = append(result, Sentence{Start: s.Start, End: s.End, Text: s.Text})
+	}
+	return result
+}
+
+// blockstart returns the start of the block containing offset for the StructuredSentenceTokenizer,
+// the start of the sentence otherwise
+func (p *PunktSentenceSplitter) blockstart(text string, offset int) int {
+	if b, ok := p.Tokenizer.(blocksplitter); ok {
+		return b.blockstart(text, offset)
+	}
+	return offset
+}
+
+// SegmentWordSplitter splits sentences into words according to the Unicode word boundaries of UAX #29.
+// It is the default WordSplitter.
+type SegmentWordSplitter struct{}
+
+// NewSegmentWordSplitter returns the default WordSplitter
+func NewSegmentWordSplitter() *SegmentWordSplitter {
+	return &SegmentWordSplitter{}
+}
+
+// Words returns the letter and number segments of sentence
+func (SegmentWordSplitter) Words(sentence string) ([]Word, error) {
+	var result []Word
+	var offset int
+
+	// segment the sentence in memory, a reader limits tokens to the 64 KB buffer of a bufio.Scanner
+	segmenter := segment.NewWordSegmenterDirect([]byte(sentence))
+	for segmenter.Segment() {
+		seglen := len(segmenter.Bytes())
+		switch segmenter.Type() {
+		case segment.Letter:
+			result = append(result, Word{Start: offset, End: offset + seglen, Text: segmenter.Text(), Kind: Letters})
+		case segment.Number:
+			result = append(result, Word{Start: offset, End: offset + seglen, Text: segmenter.Text(), Kind: Number})
+		}
+		offset += seglen
+	}
+	return result, segmenter.Err()
+}
@@ -0,0 +1,35 @@
+package readability
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSegmentWordSplitterLongSentence(t *testing.T) {
+	// an unpunctuated dump well beyond the 64 KB token limit of a bufio.Scanner
+	sentence := strings.Repeat("Tabelle Zeile 42 ", 10000)
+
+	words, err := NewSegmentWordSplitter().Words(sentence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(words) != 30000 {
+		t.Fatalf("expected 30000 words, got %d", len(words))
+	}
+	last := words[len(words)-1]
+	if last.Text != "42" || last.Kind != Number || sentence[last.Start:last.End] != "42" {
+		t.Errorf("unexpected last word %+v", last)
+	}
+
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := r.Analyze(sentence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.Sentences != 1 || ts.Words != 20000 {
+		t.Errorf("unexpected statistics %+v", ts)
+	}
+}
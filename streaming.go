@@ -0,0 +1,156 @@
+package readability
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// number of bytes a StreamAnalyzer collects before splitting them into sentences
+	streamchunksize = 64 * 1024
+	// sentences longer than this many bytes are split by a StreamAnalyzer to keep its memory bounded
+	streammaxsentence = 1024 * 1024
+)
+
+// StreamAnalyzer gathers TextStatistics from text written to it in pieces of any size, like Analyze does
+// for a string. It keeps only the text not yet split and the sentence in progress in memory, with structured
+// segmentation the block of lines in progress, up to streammaxsentence bytes.
+type StreamAnalyzer struct {
+	r   *Readability
+	ctx context.Context
+	ts  TextStatistics
+	// text not analyzed yet, starting with the sentence which may continue in text written later
+	pending []byte
+	// number of bytes written since pending was last split into sentences
+	unsplit int
+	// anything but white space has been written
+	nonblank bool
+	err      error
+}
+
+// NewStreamAnalyzer returns a StreamAnalyzer using the sentence and word splitting of the engine
+func (r *Readability) NewStreamAnalyzer() *StreamAnalyzer {
+	return r.NewStreamAnalyzerContext(context.Background())
+}
+
+// NewStreamAnalyzerContext returns a StreamAnalyzer which checks ctx before every sentence and fails with
+// the error of ctx once ctx is done
+func (r *Readability) NewStreamAnalyzerContext(ctx context.Context) *StreamAnalyzer {
+	return &StreamAnalyzer{r: r, ctx: ctx, ts: r.newtextstatistics()}
+}
+
+// AnalyzeReader gathers the statistics of the text read from rd without holding the whole text in memory.
+// The statistics match those of Analyze, also for sentences longer than the 64 KB the text is read in.
+// Only a sentence, or a block of lines with structured segmentation, longer than a megabyte is split
+// into parts counted as sentences of their own, a word at the split may be cut in two.
+func (r *Readability) AnalyzeReader(rd io.Reader) (*TextStatistics, error) {
+	return r.AnalyzeReaderContext(context.Background(), rd)
+}
+
+// AnalyzeReaderContext is AnalyzeReader, stopping with the error of ctx once ctx is done.
+func (r *Readability) AnalyzeReaderContext(ctx context.Context, rd io.Reader) (*TextStatistics, error) {
+	a := r.NewStreamAnalyzerContext(ctx)
+	if _, err := io.Copy(a, rd); err != nil {
+		return nil, err
+	}
+	return a.Statistics()
+}
+
+// Write adds text, which may end within a sentence, a word or even a character
+func (a *StreamAnalyzer) Write(p []byte) (int, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+	a.pending = append(a.pending, p...)
+	a.unsplit += len(p)
+	a.nonblank = a.nonblank || len(bytes.TrimSpace(p)) > 0
+	if a.unsplit >= streamchunksize {
+		a.err = a.analyze(false)
+	}
+	return len(p), a.err
+}
+
+// Statistics analyzes the remaining text and returns the statistics of all text written.
+// Returns ErrEmptyText if nothing but white space has been written.
+func (a *StreamAnalyzer) Statistics() (*TextStatistics, error) {
+	if a.err == nil && !a.nonblank {
+		a.err = ErrEmptyText
+	}
+	if a.err == nil {
+		a.err = a.analyze(true)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	ts := a.ts
+	return &ts, nil
+}
+
+// analyze splits the pending text into sentences and analyzes all but the last one, which may continue.
+// At the end of the text the last sentence is analyzed as well. If the pending text grows too long, it is split
+// even within a word and the last sentence is kept, unless it is all of the pending text.
+func (a *StreamAnalyzer) analyze(end bool) error {
+	a.unsplit = 0
+
+	// split complete words only, a sentence boundary depends on the word following it
+	cut := len(a.pending)
+	if !end {
+		cut = bytes.LastIndexAny(a.pending, " \t\r\n") + 1
+	}
+	// a sentence too long to keep in memory is split, if need be within a word
+	force := !end && len(a.pending) >= streammaxsentence
+	if force && cut == 0 {
+		for cut = len(a.pending); cut > 0 && !utf8.RuneStart(a.pending[cut-1]); cut-- {
+		}
+		if cut > 0 {
+			cut--
+		}
+	}
+	if cut == 0 {
+		return nil
+	}
+
+	sentences := a.r.sentencesplitter.Sentences(string(a.pending[:cut]))
+	analyzed, rest := len(sentences), cut
+	if !end {
+		// the boundary after the last sentence with a word was found without knowing the word following it,
+		// keep that sentence and the white space after it
+		for analyzed > 0 && strings.TrimSpace(sentences[analyzed-1].Text) == "" {
+			analyzed--
+		}
+		rest = 0
+		if analyzed > 0 {
+			analyzed--
+			rest = sentences[analyzed].Start
+		}
+		// splitters which depend on the surrounding lines must restart at the start of a block,
+		// unless the block has grown too long to keep
+		if b, ok := a.r.sentencesplitter.(blocksplitter); ok {
+			if start := b.blockstart(string(a.pending[:cut]), rest); start > 0 || !force {
+				rest = start
+				for analyzed > 0 && sentences[analyzed-1].End > rest {
+					analyzed--
+				}
+			}
+		}
+		if force && rest == 0 {
+			analyzed, rest = len(sentences), cut
+		}
+	}
+	for _, s := range sentences[:analyzed] {
+		if err := a.ctx.Err(); err != nil {
+			return err
+		}
+		sts, err := a.r.analyzesentence(s.Text)
+		if err != nil {
+			return err
+		}
+		a.ts.add(sts)
+	}
+
+	a.pending = append(a.pending[:0], a.pending[rest:]...)
+	return nil
+}
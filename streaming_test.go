@@ -0,0 +1,70 @@
+package readability
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// streamingtext returns a text of several chunks of the StreamAnalyzer with paragraphs, headings, lists
+// and a sentence longer than a chunk
+func streamingtext(lang string) string {
+	paragraphs := map[string]string{
+		"de": "# Förderung der Gemeinden\n\nDer Gemeinderat hat die Erweiterung des Kindergartens beschlossen. " +
+			"Die Kosten betragen rund zwei Mio. Euro und werden zur Hälfte vom Land getragen.\n" +
+			"Mit dem Bau soll im Frühjahr begonnen werden.\n\n" +
+			"- Antrag bei der Bezirkshauptmannschaft\n- Nachweis der Kosten gem. Abs. 3\n\nZuständigkeit\n" +
+			"Die Zuerkennung setzt voraus, dass die Voraussetzungen der Richtlinie erfüllt werden.\n\n",
+		"en": "# Funding for schools\n\nThe council approved the extension of the school on Tuesday. " +
+			"The costs of about two million dollars are shared by Dr. Smith's foundation and the state.\n" +
+			"Construction begins in spring.\n\n" +
+			"- Application form\n- Proof of costs\n\nResponsibility\n" +
+			"The grant requires that the conditions of the guideline are met.\n\n",
+	}
+	text := strings.Repeat(paragraphs[lang], 2*streamchunksize/len(paragraphs[lang]))
+	return text + strings.Repeat("Tabelle Zeile 42 ", 2*streamchunksize/17) + "Ende.\n\n" + text
+}
+
+func TestAnalyzeReaderMatchesAnalyze(t *testing.T) {
+	for _, lang := range []string{"de", "en"} {
+		for _, structured := range []bool{false, true} {
+			var opts []Option
+			if structured {
+				opts = append(opts, WithStructuredSegmentation())
+			}
+			r, err := NewReadability(lang, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			text := streamingtext(lang)
+			want, err := r.Analyze(text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			readers := map[string]func(io.Reader) io.Reader{"OneByteReader": iotest.OneByteReader, "HalfReader": iotest.HalfReader}
+			for name, reader := range readers {
+				got, err := r.AnalyzeReader(reader(strings.NewReader(text)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s structured %t %s: statistics %+v, Analyze %+v", lang, structured, name, got, want)
+					continue
+				}
+				for _, compare_type := range r.CompareTypes() {
+					gotscore, err := got.Score(compare_type)
+					if err != nil {
+						t.Fatal(err)
+					}
+					wantscore, _ := want.Score(compare_type)
+					if gotscore != wantscore {
+						t.Errorf("%s structured %t %s %s: score %g, Analyze %g", lang, structured, name, compare_type, gotscore, wantscore)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package readability
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/neurosnap/sentences"
+)
+
+// lines shorter than this many characters end a sentence by default
+const defaultshortline = 40
+
+var (
+	// list items like "- item", "* item", "• item", "1. item" or "a) item"
+	listmarker = regexp.MustCompile(`^\s*([-*•+]|\d+[.)]|[a-zA-Z][)])\s+`)
+	// markdown headings like "## heading"
+	headingmarker = regexp.MustCompile(`^\s*#{1,6}\s+`)
+)
+
+// StructuredSentenceTokenizer wraps the Punkt sentence tokenizer for texts with structure but without
+// sentence punctuation, like bullet lists, headings or line by line titles. Blank lines, list items,
+// headings and lines shorter than ShortLine characters always end a sentence, Punkt splits the remaining text.
+// List markers and heading markers are not part of any sentence.
+type StructuredSentenceTokenizer struct {
+	*sentences.DefaultSentenceTokenizer
+	// lines shorter than ShortLine characters end a sentence, 0 disables the rule
+	ShortLine int
+}
+
+// NewStructuredSentenceTokenizer wraps tokenizer, short lines are lines of less than 40 characters
+func NewStructuredSentenceTokenizer(tokenizer *sentences.DefaultSentenceTokenizer) *StructuredSentenceTokenizer {
+	return &StructuredSentenceTokenizer{DefaultSentenceTokenizer: tokenizer, ShortLine: defaultshortline}
+}
+
+// Tokenize splits text into blocks at the text structure and each block into sentences.
+// Sentences without any non white space character are dropped.
+func (t *StructuredSentenceTokenizer) Tokenize(text string) []*sentences.Sentence {
+
+	var result []*sentences.Sentence
+
+	// byte range of the current block, blockstart is -1 outside a block
+	blockstart, blockend := -1, -1
+	flush := func() {
+		if blockstart < 0 {
+			return
+		}
+		for _, s := range t.DefaultSentenceTokenizer.Tokenize(text[blockstart:blockend]) {
+			if strings.TrimSpace(s.Text) != "" {
+				result = append(result, &sentences.Sentence{Start: blockstart + s.Start, End: blockstart + s.End, Text: s.Text})
+			}
+		}
+		blockstart = -1
+	}
+
+	for offset := 0; offset < len(text); {
+		lineend := len(text)
+		if i := strings.IndexByte(text[offset:], '\n'); i >= 0 {
+			lineend = offset + i
+		}
+		line := text[offset:lineend]
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" {
+			flush()
+		} else {
+			content := offset
+			if m := listmarker.FindStringIndex(line); m != nil {
+				flush()
+				content = offset + m[1]
+			}
+			heading := headingmarker.FindStringIndex(line)
+			if heading != nil {
+				flush()
+				content = offset + heading[1]
+			}
+
+			if blockstart < 0 {
+				blockstart = content
+			}
+			blockend = lineend
+			if heading != nil || utf8.RuneCountInString(trimmed) < t.ShortLine {
+				flush()
+			}
+		}
+		offset = lineend + 1
+	}
+	flush()
+
+	return result
+}
+
+// blockstart returns the start of the line which starts the block containing offset,
+// i.e. the first line following a blank line, a heading or a short line, or starting with a list marker or a heading
+func (t *StructuredSentenceTokenizer) blockstart(text string, offset int) int {
+	start := strings.LastIndexByte(text[:offset], '\n') + 1
+	for start > 0 {
+		line := text[start:]
+		if end := strings.IndexByte(line, '\n'); end >= 0 {
+			line = line[:end]
+		}
+		if listmarker.MatchString(line) || headingmarker.MatchString(line) {
+			return start
+		}
+
+		previousstart := strings.LastIndexByte(text[:start-1], '\n') + 1
+		previous := text[previousstart : start-1]
+		trimmed := strings.TrimSpace(previous)
+		if trimmed == "" || headingmarker.MatchString(previous) || utf8.RuneCountInString(trimmed) < t.ShortLine {
+			return start
+		}
+		start = previousstart
+	}
+	return 0
+}
@@ -0,0 +1,74 @@
+package readability
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neurosnap/sentences"
+)
+
+func TestStructuredSentenceTokenizer(t *testing.T) {
+	tokenizer := NewStructuredSentenceTokenizer(sentences.NewSentenceTokenizer(sentences.NewStorage()))
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"list markers",
+			"- Wasser\n* Brot\n• Salz\n1. Mehl und Zucker\n2) Eier\na) Milch\n",
+			[]string{"Wasser", "Brot", "Salz", "Mehl und Zucker", "Eier", "Milch"}},
+		{"headings",
+			"# Einleitung\nDer erste Absatz hat keinen Punkt am Ende und läuft über zwei Zeilen\nweiter bis hierher\n## Schluss",
+			[]string{"Einleitung", "Der erste Absatz hat keinen Punkt am Ende und läuft über zwei Zeilen\nweiter bis hierher", "Schluss"}},
+		{"blank lines",
+			"Der erste Absatz hat keinen Punkt am Ende, er endet an der Leerzeile\n\n\nDer zweite Absatz endet mit einem Punkt. Danach kommt noch ein Satz.",
+			[]string{"Der erste Absatz hat keinen Punkt am Ende, er endet an der Leerzeile", "Der zweite Absatz endet mit einem Punkt.", "Danach kommt noch ein Satz."}},
+		{"short lines",
+			"Kurzer Titel\nUntertitel\nEine lange Zeile ohne Satzzeichen, die in der nächsten Zeile\nweitergeht und mit einer kurzen Zeile endet",
+			[]string{"Kurzer Titel", "Untertitel", "Eine lange Zeile ohne Satzzeichen, die in der nächsten Zeile\nweitergeht und mit einer kurzen Zeile endet"}},
+		{"white space only", " \n\t\n", nil},
+	}
+	for _, test := range tests {
+		var got []string
+		for _, s := range tokenizer.Tokenize(test.text) {
+			if test.text[s.Start:s.End] != s.Text {
+				t.Errorf("%s: offsets %d-%d do not match %q", test.name, s.Start, s.End, s.Text)
+			}
+			got = append(got, strings.TrimSpace(s.Text))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.want, got)
+		}
+	}
+
+	// without the short line rule short lines continue the block
+	tokenizer.ShortLine = 0
+	if got := tokenizer.Tokenize("Kurzer Titel\nUntertitel"); len(got) != 1 {
+		t.Errorf("expected one sentence with ShortLine 0, got %d", len(got))
+	}
+}
+
+func TestStructuredSentenceTokenizerBlockstart(t *testing.T) {
+	tokenizer := NewStructuredSentenceTokenizer(sentences.NewSentenceTokenizer(sentences.NewStorage()))
+
+	long1 := "Eine lange Zeile ohne Satzzeichen, die in der nächsten Zeile"
+	long2 := "weitergeht und erst mit einer kurzen Zeile endet, nicht vorher"
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"first line", long1 + "\n" + long2, 0},
+		{"after a blank line", "Absatz\n\n" + long1 + "\n" + long2, len("Absatz\n\n")},
+		{"after a heading", "# Titel der Seite, lang genug für keine kurze Zeile\n" + long1 + "\n" + long2, len("# Titel der Seite, lang genug für keine kurze Zeile\n")},
+		{"after a short line", "Kurzer Titel\n" + long1 + "\n" + long2, len("Kurzer Titel\n")},
+		{"list item", long1 + "\n- " + long1 + "\n" + long2, len(long1 + "\n")},
+	}
+	for _, test := range tests {
+		if got := tokenizer.blockstart(test.text, len(test.text)); got != test.want {
+			t.Errorf("%s: expected %d, got %d", test.name, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package readability
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SyllableGold is a word of a gold standard word list together with its correct number of syllables
+type SyllableGold struct {
+	Word      string
+	Syllables int
+}
+
+// SyllableError is a word whose syllables were counted wrong
+type SyllableError struct {
+	Word      string
+	Syllables int // the correct number of syllables
+	Counted   int
+}
+
+// SyllableEvaluation is the result of running a SyllableCounter on a gold standard word list
+type SyllableEvaluation struct {
+	Counter  string
+	Words    int
+	Correct  int
+	Accuracy float64
+	// correct number of syllables mapped to the counted number of syllables mapped to the number of words
+	Confusion map[int]map[int]int
+	// the words counted wrong, the largest errors first
+	Errors []SyllableError
+}
+
+// ReadSyllableGold reads a gold standard word list in TSV format: one word and its number of syllables per line,
+// separated by a tab. Empty lines and lines starting with # are skipped.
+func ReadSyllableGold(r io.Reader) ([]SyllableGold, error) {
+
+	var gold []SyllableGold
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, "\t")
+		if len(fields) != 2 {
+			return nil, errors.New(fmt.Sprintf("ReadSyllableGold: line %d: expected word and syllables separated by a tab", line))
+		}
+		syllables, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("ReadSyllableGold: line %d: %s", line, err.Error()))
+		}
+		gold = append(gold, SyllableGold{Word: strings.TrimSpace(fields[0]), Syllables: syllables})
+	}
+	return gold, s.Err()
+}
+
+// EvaluateSyllableCounter counts the syllables of every gold standard word by counter and compares them to the gold standard
+func EvaluateSyllableCounter(name string, counter SyllableCounter, gold []SyllableGold) SyllableEvaluation {
+
+	e := SyllableEvaluation{Counter: name, Words: len(gold), Confusion: make(map[int]map[int]int)}
+	for _, g := range gold {
+		counted := counter.Syllables(g.Word)
+
+		if e.Confusion[g.Syllables] == nil {
+			e.Confusion[g.Syllables] = make(map[int]int)
+		}
+		e.Confusion[g.Syllables][counted]++
+
+		if counted == g.Syllables {
+			e.Correct++
+		} else {
+			e.Errors = append(e.Errors, SyllableError{Word: g.Word, Syllables: g.Syllables, Counted: counted})
+		}
+	}
+	if e.Words > 0 {
+		e.Accuracy = float64(e.Correct) / float64(e.Words)
+	}
+
+	sort.SliceStable(e.Errors, func(i, j int) bool {
+		return abs(e.Errors[i].Counted-e.Errors[i].Syllables) > abs(e.Errors[j].Counted-e.Errors[j].Syllables)
+	})
+	return e
+}
+
+// SyllableCounters returns every syllable counter available for the language of the engine, keyed by name:
+// hyphenation counts the vowel nuclei of the parts between hyphenation points, german and vowels (german without hyphenation patterns) are german only.
+func (r *Readability) SyllableCounters() map[string]SyllableCounter {
+	counters := make(map[string]SyllableCounter)
+	if r.hyphen != nil {
+		counters["hyphenation"] = newlanguagesyllablecounter(r.Hyphenate, r.lang)
+	}
+	if baselanguage(r.lang) == "de" {
+		counters["vowels"] = NewGermanSyllableCounter(nil)
+		if r.hyphen != nil {
+			counters["german"] = NewGermanSyllableCounter(r.Hyphenate)
+		}
+	}
+	return counters
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
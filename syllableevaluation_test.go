@@ -0,0 +1,102 @@
+package readability
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReadSyllableGold(t *testing.T) {
+	gold, err := ReadSyllableGold(strings.NewReader("# comment\nHaus\t1\n\nStraße\t2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gold) != 2 || gold[0] != (SyllableGold{"Haus", 1}) || gold[1] != (SyllableGold{"Straße", 2}) {
+		t.Errorf("unexpected gold standard %v", gold)
+	}
+
+	if _, err := ReadSyllableGold(strings.NewReader("Haus 1\n")); err == nil {
+		t.Error("expected an error for a line without tab")
+	}
+	if _, err := ReadSyllableGold(strings.NewReader("Haus\teins\n")); err == nil {
+		t.Error("expected an error for a non numeric syllable count")
+	}
+}
+
+func TestEvaluateSyllableCounter(t *testing.T) {
+	gold := []SyllableGold{{"a", 1}, {"aba", 2}, {"ebebe", 3}, {"oooooo", 1}}
+	e := EvaluateSyllableCounter("letters", NewHyphenationSyllableCounter(func(word string) []int {
+		// a break point after every letter
+		var hyphens []int
+		for i := 1; i < len(word); i++ {
+			hyphens = append(hyphens, i)
+		}
+		return hyphens
+	}), gold)
+
+	if e.Words != 4 || e.Correct != 3 || e.Accuracy != 0.75 {
+		t.Errorf("unexpected evaluation %+v", e)
+	}
+	if e.Confusion[1][6] != 1 || e.Confusion[2][2] != 1 {
+		t.Errorf("unexpected confusion %v", e.Confusion)
+	}
+	if len(e.Errors) != 1 || e.Errors[0] != (SyllableError{"oooooo", 1, 6}) {
+		t.Errorf("unexpected errors %v", e.Errors)
+	}
+}
+
+func TestSyllableCountersGerman(t *testing.T) {
+	f, err := os.Open("testdata/syllables-de.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gold, err := ReadSyllableGold(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	counters := r.SyllableCounters()
+	for _, name := range []string{"hyphenation", "german", "vowels"} {
+		if counters[name] == nil {
+			t.Fatalf("syllable counter %s not available", name)
+		}
+	}
+
+	// words the exception dictionary decides would measure the dictionary, not the rules
+	var heldout []SyllableGold
+	for _, g := range gold {
+		var exception bool
+		for word := range germansyllableexceptions {
+			exception = exception || strings.HasSuffix(strings.ToLower(g.Word), word)
+		}
+		if !exception {
+			heldout = append(heldout, g)
+		}
+	}
+	if len(heldout) == len(gold) {
+		t.Fatal("expected the gold standard to contain words of the exception dictionary")
+	}
+
+	evaluations := make(map[string]SyllableEvaluation)
+	for name, counter := range counters {
+		e := EvaluateSyllableCounter(name, counter, heldout)
+		evaluations[name] = e
+		t.Logf("%s: %d of %d words correct (%.1f%%)", name, e.Correct, e.Words, 100*e.Accuracy)
+		for _, w := range e.Errors {
+			t.Logf("  %s: %d instead of %d", w.Word, w.Counted, w.Syllables)
+		}
+	}
+
+	// the rules count 130 of the 133 words left correctly
+	if a := evaluations["german"].Accuracy; a < 0.97 {
+		t.Errorf("german syllable counter accuracy %.3f below 0.97", a)
+	}
+	if evaluations["german"].Accuracy < evaluations["hyphenation"].Accuracy {
+		t.Error("german syllable counter less accurate than counting hyphenation points")
+	}
+}
@@ -0,0 +1,302 @@
+package readability
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SyllableCounter counts the syllables of a word
+type SyllableCounter interface {
+	Syllables(word string) int
+}
+
+// HyphenateFunc returns the rune offsets within word where syllables are split, like Readability.Hyphenate
+type HyphenateFunc func(word string) []int
+
+// syllablebreaker is implemented by syllable counters which split words at hyphenation points
+type syllablebreaker interface {
+	// breaks returns the hyphenation points of word the counter splits syllables at
+	breaks(word string) []int
+}
+
+// HyphenationSyllableCounter splits a word at its hyphenation points and counts the vowel nuclei of every part,
+// where vowels next to each other form a single nucleus. Points leaving a part without a vowel, like the final t
+// of "tex-t", are dropped, and a part with several nuclei, like "vail" of "a-vail-able", counts each of them.
+type HyphenationSyllableCounter struct {
+	Hyphenate HyphenateFunc
+	// the final e of a word is silent after a consonant as in "house" or "village", but not after a consonant and l
+	// as in "table"
+	SilentFinalE bool
+	// two of the strong vowels a, e and o next to each other, or a stressed í or ú next to another vowel,
+	// are separate nuclei as in spanish "po-e-ta" or "pa-ís"
+	Hiatus bool
+}
+
+// NewHyphenationSyllableCounter returns a HyphenationSyllableCounter counting every vowel nucleus
+func NewHyphenationSyllableCounter(hyphenate HyphenateFunc) SyllableCounter {
+	return &HyphenationSyllableCounter{Hyphenate: hyphenate}
+}
+
+// newlanguagesyllablecounter returns the HyphenationSyllableCounter for lang: english and french have a silent final e,
+// spanish and italian strong vowels in hiatus
+func newlanguagesyllablecounter(hyphenate HyphenateFunc, lang string) SyllableCounter {
+	base := baselanguage(lang)
+	return &HyphenationSyllableCounter{
+		Hyphenate:    hyphenate,
+		SilentFinalE: base == "en" || base == "fr",
+		Hiatus:       base == "es" || base == "it",
+	}
+}
+
+func (h *HyphenationSyllableCounter) Syllables(word string) int {
+	return len(h.breaks(word)) + 1
+}
+
+// breaks returns the rune offsets of word between its syllables: the hyphenation points with a vowel on either side,
+// and points splitting parts with several nuclei
+func (h *HyphenationSyllableCounter) breaks(word string) []int {
+	runes := []rune(word)
+
+	var points []int
+	var start int
+	for _, pos := range h.Hyphenate(word) {
+		if pos > start && pos < len(runes) && len(h.nuclei(runes, start, pos)) > 0 && len(h.nuclei(runes, pos, len(runes))) > 0 {
+			points = append(points, pos)
+			start = pos
+		}
+	}
+
+	var result []int
+	start = 0
+	for _, end := range append(points, len(runes)) {
+		result = append(result, splitnuclei(h.nuclei(runes, start, end), start)...)
+		if end < len(runes) {
+			result = append(result, end)
+		}
+		start = end
+	}
+	return result
+}
+
+// nuclei returns the vowel nuclei of word[start:end] with offsets relative to start, leaving out a silent final e
+func (h *HyphenationSyllableCounter) nuclei(word []rune, start, end int) [][2]int {
+	result := nuclei(word[start:end], nil, h.Hiatus)
+	if h.SilentFinalE && end == len(word) && len(result) > 0 && result[len(result)-1][0] == end-start-1 && silentfinale(word) {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+// silentfinale reports whether word ends in an e following a consonant which is not preceded by a consonant and l
+func silentfinale(word []rune) bool {
+	n := len(word)
+	if n < 2 || unicode.ToLower(word[n-1]) != 'e' || isvowel(word, n-2) {
+		return false
+	}
+	return !(unicode.ToLower(word[n-2]) == 'l' && n >= 3 && !isvowel(word, n-3))
+}
+
+// nuclei returns the start and end offsets of the vowel nuclei of part. Vowels next to each other form a single nucleus
+// unless they are in hiatus, if hiatus is set. If pairs is given, only the vowels of a pair listed do, like the german
+// diphthongs.
+func nuclei(part []rune, pairs []string, hiatus bool) [][2]int {
+	var result [][2]int
+	for i := 0; i < len(part); i++ {
+		if !isvowel(part, i) {
+			continue
+		}
+		end := i + 1
+		if pairs == nil {
+			for end < len(part) && isvowel(part, end) && !(hiatus && inhiatus(part[end-1], part[end])) {
+				end++
+			}
+		} else if end < len(part) {
+			pair := strings.ToLower(string(part[i : i+2]))
+			for _, p := range pairs {
+				if pair == p {
+					end++
+					break
+				}
+			}
+		}
+		result = append(result, [2]int{i, end})
+		i = end - 1
+	}
+	return result
+}
+
+// inhiatus reports whether the vowels first and second are separate nuclei in spanish and italian
+func inhiatus(first, second rune) bool {
+	first, second = unicode.ToLower(first), unicode.ToLower(second)
+	strong := func(c rune) bool { return strings.ContainsRune("aeoáéóàèò", c) }
+	stressed := func(c rune) bool { return strings.ContainsRune("íúìù", c) }
+	return strong(first) && strong(second) || stressed(first) || stressed(second)
+}
+
+// splitnuclei returns the offsets between the nuclei of a part starting at offset: in front of the last consonant
+// between two nuclei, or right in front of the second nucleus if there is none
+func splitnuclei(n [][2]int, offset int) []int {
+	var result []int
+	for k := 1; k < len(n); k++ {
+		split := n[k][0]
+		if split > n[k-1][1] {
+			split--
+		}
+		result = append(result, offset+split)
+	}
+	return result
+}
+
+// GermanSyllableCounter counts the syllables of german words.
+// Words found in Exceptions, or ending in one, take their count from there. Otherwise the word is split at
+// the hyphenation points and the vowel nuclei of every part are counted, so vowels the patterns separate like
+// "e-u" in "be-ur-tei-len" are not taken for a diphthong. Parts without a vowel, like "t" in "Ma-gis-t-ra-t", do not count.
+// Every word has at least one syllable. Counting the hyphenation points instead counts too many syllables,
+// the german patterns split off single consonants as in "Hun-d" or "Schif-f".
+type GermanSyllableCounter struct {
+	// may be nil, vowel nuclei are then counted across the whole word
+	Hyphenate HyphenateFunc
+	// lower case words mapped to their number of syllables
+	Exceptions map[string]int
+}
+
+// NewGermanSyllableCounter returns a GermanSyllableCounter using hyphenate and the default exception dictionary.
+// hyphenate may be nil.
+func NewGermanSyllableCounter(hyphenate HyphenateFunc) SyllableCounter {
+	exceptions := make(map[string]int, len(germansyllableexceptions))
+	for word, syllables := range germansyllableexceptions {
+		exceptions[word] = syllables
+	}
+	return &GermanSyllableCounter{Hyphenate: hyphenate, Exceptions: exceptions}
+}
+
+// words the vowel nucleus rules get wrong, mostly vowels in hiatus which look like a diphthong or long vowel
+var germansyllableexceptions = map[string]int{
+	"ferien":    3,
+	"medien":    3,
+	"studien":   3,
+	"italien":   4,
+	"spanien":   3,
+	"ideen":     3,
+	"museum":    3,
+	"museen":    3,
+	"naiv":      2,
+	"koffein":   3,
+	"ruine":     3,
+	"pfui":      1,
+	"jubiläum":  4,
+	"mausoleum": 4,
+	"atheist":   3,
+}
+
+// two vowels forming a single syllable nucleus
+var germannuclei = []string{"aa", "ai", "au", "ay", "äu", "ee", "ei", "eu", "ey", "ie", "oo", "oi", "ou"}
+
+func (g *GermanSyllableCounter) Syllables(word string) int {
+	return len(g.breaks(word)) + 1
+}
+
+func (g *GermanSyllableCounter) hyphenate(word string) []int {
+	if g.Hyphenate == nil {
+		return nil
+	}
+	return g.Hyphenate(word)
+}
+
+// breaks returns the rune offsets of word between its syllables
+func (g *GermanSyllableCounter) breaks(word string) []int {
+
+	lower := strings.ToLower(word)
+
+	// the longest exception the word ends with, the remainder is split by the rules
+	var exceptionsuffix string
+	for exception := range g.Exceptions {
+		if len(exception) > len(exceptionsuffix) && strings.HasSuffix(lower, exception) {
+			exceptionsuffix = exception
+		}
+	}
+	if exceptionsuffix == "" {
+		return splitgermanparts([]rune(lower), g.hyphenate(word))
+	}
+
+	prefix := []rune(lower[:len(lower)-len(exceptionsuffix)])
+	var result []int
+	if len(prefix) > 0 {
+		result = splitgermanparts(prefix, g.hyphenate(string(prefix)))
+		if countnuclei(prefix) > 0 {
+			result = append(result, len(prefix))
+		}
+	}
+	suffix := fitnuclei(nuclei([]rune(exceptionsuffix), germannuclei, false), g.Exceptions[exceptionsuffix])
+	return append(result, splitnuclei(suffix, len(prefix))...)
+}
+
+// splitgermanparts returns the hyphenation points of word with a vowel nucleus on either side, and points splitting
+// parts with several nuclei. The patterns occasionally split off the final letter, which never forms a syllable of its own.
+func splitgermanparts(word []rune, hyphens []int) []int {
+	var points []int
+	var start int
+	for _, pos := range hyphens {
+		if pos > start && pos < len(word)-1 && countnuclei(word[start:pos]) > 0 && countnuclei(word[pos:]) > 0 {
+			points = append(points, pos)
+			start = pos
+		}
+	}
+
+	var result []int
+	start = 0
+	for _, end := range append(points, len(word)) {
+		result = append(result, splitnuclei(nuclei(word[start:end], germannuclei, false), start)...)
+		if end < len(word) {
+			result = append(result, end)
+		}
+		start = end
+	}
+	return result
+}
+
+// fitnuclei splits the last nuclei of two vowels or merges the last nuclei until there are count of them
+func fitnuclei(n [][2]int, count int) [][2]int {
+	for len(n) < count {
+		i := len(n) - 1
+		for i >= 0 && n[i][1]-n[i][0] < 2 {
+			i--
+		}
+		if i < 0 {
+			break
+		}
+		split := [][2]int{{n[i][0], n[i][0] + 1}, {n[i][0] + 1, n[i][1]}}
+		n = append(n[:i:i], append(split, n[i+1:]...)...)
+	}
+	for len(n) > count && len(n) > 1 {
+		last := len(n) - 1
+		n = append(n[:last-1:last-1], [2]int{n[last-1][0], n[last][1]})
+	}
+	return n
+}
+
+// countnuclei counts the vowel groups of part, where a group is a single vowel or one of germannuclei
+func countnuclei(part []rune) int {
+	return len(nuclei(part, germannuclei, false))
+}
+
+// isvowel reports whether the rune at position i of word is a vowel. A u following q is part of the consonant.
+func isvowel(word []rune, i int) bool {
+	switch unicode.ToLower(word[i]) {
+	case 'u':
+		return i == 0 || unicode.ToLower(word[i-1]) != 'q'
+	case 'a', 'e', 'i', 'o', 'y', 'ä', 'ö', 'ü', 'ë', 'ï', 'á', 'é', 'í', 'ó', 'ú', 'à', 'è', 'ì', 'ò', 'ù', 'â', 'ê', 'î', 'ô', 'û':
+		return true
+	}
+	return false
+}
+
+// Hyphenate returns the rune offsets within word where syllables are split according to the hyphenation patterns
+// of the engine. Returns nil if the engine has no hyphenation patterns.
+func (r *Readability) Hyphenate(word string) []int {
+	if r.hyphen == nil {
+		return nil
+	}
+	return r.hyphenate(word, utf8.RuneCountInString(word))
+}
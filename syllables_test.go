@@ -0,0 +1,137 @@
+package readability
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestHyphenationSyllableCounter(t *testing.T) {
+	tests := map[string]map[string]int{
+		"en": {"house": 1, "text": 1, "cat": 1, "table": 2, "water": 2, "people": 2, "simple": 2, "beautiful": 3, "important": 3, "example": 3, "information": 4,
+			"university": 5, "available": 4, "academy": 4, "necessary": 4},
+		"fr": {"maison": 2, "enfant": 2, "jardin": 2, "village": 2, "difficile": 3, "chocolat": 3, "information": 4, "ordinateur": 4},
+		"es": {"casa": 2, "mesa": 2, "niño": 2, "jardín": 2, "difícil": 3, "ventana": 3, "escuela": 3, "chocolate": 4, "información": 4,
+			"palabra": 3, "gobierno": 3, "teléfono": 4, "ciudad": 2, "bueno": 2, "país": 2, "día": 2, "leer": 2, "poeta": 3, "aéreo": 4},
+		"it": {"casa": 2, "scuola": 2, "tavola": 3, "bambino": 3, "parola": 3, "giardino": 3, "difficile": 4, "telefono": 4, "informazione": 5,
+			"finestra": 3, "paura": 2, "poeta": 3, "aereo": 4},
+		"nl": {"huis": 1, "kind": 1, "school": 1, "tafel": 2, "lezen": 2, "moeilijk": 2, "regering": 3, "computer": 3, "informatie": 4,
+			"venster": 2, "idee": 2, "theater": 3, "piano": 3},
+	}
+	for lang, words := range tests {
+		r, err := NewReadability(lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for word, syllables := range words {
+			if counted := r.syllables.Syllables(word); counted != syllables {
+				t.Errorf("%s %s: expected %d syllables, counted %d (hyphenation points %v)", lang, word, syllables, counted, r.Hyphenate(word))
+			}
+		}
+	}
+}
+
+func TestHyphenationSyllableCounterBreaks(t *testing.T) {
+	hyphenate := func(points ...int) HyphenateFunc {
+		return func(string) []int { return points }
+	}
+	tests := []struct {
+		word         string
+		hyphenate    HyphenateFunc
+		silentfinale bool
+		want         []int
+	}{
+		// points at the word boundaries and points leaving a part without a vowel are dropped
+		{"text", hyphenate(0, 3, 4), false, nil},
+		// a part with several nuclei is split in front of the last consonant between them
+		{"available", hyphenate(1, 5), false, []int{1, 5, 7}},
+		// vowels in hiatus are one nucleus
+		{"idea", hyphenate(), false, []int{1}},
+		{"house", hyphenate(), true, nil},
+		{"house", hyphenate(), false, []int{3}},
+		{"table", hyphenate(2), true, []int{2}},
+	}
+	for _, test := range tests {
+		h := &HyphenationSyllableCounter{Hyphenate: test.hyphenate, SilentFinalE: test.silentfinale}
+		if got := h.breaks(test.word); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: breaks %v, expected %v", test.word, got, test.want)
+		}
+		if got := h.Syllables(test.word); got != len(test.want)+1 {
+			t.Errorf("%s: %d syllables, expected %d", test.word, got, len(test.want)+1)
+		}
+	}
+}
+
+func TestSyllableCounterEnglish(t *testing.T) {
+	f, err := os.Open("testdata/syllables-en.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gold, err := ReadSyllableGold(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReadability("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := EvaluateSyllableCounter("english", r.syllables, gold)
+	t.Logf("%d of %d words correct (%.1f%%)", e.Correct, e.Words, 100*e.Accuracy)
+	for _, w := range e.Errors {
+		t.Logf("  %s: %d instead of %d", w.Word, w.Counted, w.Syllables)
+	}
+	// the counter gets 112 of the 118 words right, mostly missing vowels in hiatus like "idea"
+	if e.Accuracy < 0.94 {
+		t.Errorf("english syllable counter accuracy %.3f below 0.94", e.Accuracy)
+	}
+}
+
+func TestAnnotationHyphensMatchSyllables(t *testing.T) {
+	r, err := NewReadability("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// vowels in hiatus within a part of the patterns, and exceptions which split or merge nuclei
+	tests := map[string][]int{
+		"Theorie":    {3, 4},
+		"Aquarium":   {2, 4, 6},
+		"Ferien":     {2, 4},
+		"Italien":    {1, 3, 5},
+		"Mausoleum":  {3, 5, 7},
+		"Koffein":    {3, 5},
+		"Pfui":       nil,
+		"beurteilen": {2, 4, 7},
+		"Magistrat":  {2, 5},
+		"springt":    nil,
+	}
+	for word, want := range tests {
+		wa := r.annotateword(word)
+		if !reflect.DeepEqual(wa.Hyphens, want) || wa.Syllables != len(want)+1 {
+			t.Errorf("%s: hyphens %v and %d syllables, expected %v", word, wa.Hyphens, wa.Syllables, want)
+		}
+	}
+
+	// every word of the gold standards
+	for lang, filename := range map[string]string{"de": "testdata/syllables-de.tsv", "en": "testdata/syllables-en.tsv"} {
+		r, err := NewReadability(lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := os.Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gold, err := ReadSyllableGold(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, g := range gold {
+			if wa := r.annotateword(g.Word); len(wa.Hyphens)+1 != wa.Syllables {
+				t.Errorf("%s %s: %d syllables, but hyphens %v", lang, g.Word, wa.Syllables, wa.Hyphens)
+			}
+		}
+	}
+}
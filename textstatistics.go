@@ -0,0 +1,136 @@
+package readability
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TextStatistics holds the counts gathered from a text which all readability formulas are based on.
+// Obtain it once by calling Readability.Analyze and compute as many scores from it as required.
+type TextStatistics struct {
+	Lang            string `description:"language of the engine which gathered the statistics"`
+	Sentences       int    `description:"number of sentences containing at least one word"`
+	Words           int    `description:"number of words"`
+	Numbers         int    `description:"number of numbers, only ARI counts them as words"`
+	Syllables       int    `description:"total number of syllables, 0 if the engine has no syllable counter"`
+	NoSyllables     bool   `description:"the engine has no syllable counter, formulas based on syllables are unavailable"`
+	Polysyllables   int    `description:"number of words with three or more syllables"`
+	Monosyllables   int    `description:"number of words with one syllable"`
+	LongWords       int    `description:"number of words longer than six characters"`
+	Characters      int    `description:"number of letters and digits in words and numbers"`
+	Letters         int    `description:"number of letters in words"`
+	Nominalizations int    `description:"german only: number of nouns derived by a nominalization suffix like -ung, -heit or -keit"`
+}
+
+// Analyze splits text into sentences and words and gathers the statistics all readability formulas are based on.
+func (r *Readability) Analyze(text string) (*TextStatistics, error) {
+	return r.AnalyzeDocumentContext(context.Background(), NewDocument(text, PlainText))
+}
+
+// AnalyzeContext is Analyze, stopping with the error of ctx once ctx is done.
+func (r *Readability) AnalyzeContext(ctx context.Context, text string) (*TextStatistics, error) {
+	return r.AnalyzeDocumentContext(ctx, NewDocument(text, PlainText))
+}
+
+// AnalyzeDocument gathers the statistics of the text of a Document, a sentence never spans two blocks.
+func (r *Readability) AnalyzeDocument(d *Document) (*TextStatistics, error) {
+	return r.AnalyzeDocumentContext(context.Background(), d)
+}
+
+// AnalyzeDocumentContext is AnalyzeDocument, checking ctx before every sentence.
+// Returns ErrEmptyText if the text consists of white space only.
+func (r *Readability) AnalyzeDocumentContext(ctx context.Context, d *Document) (*TextStatistics, error) {
+
+	if strings.TrimSpace(d.Text) == "" {
+		return nil, ErrEmptyText
+	}
+
+	ts := r.newtextstatistics()
+
+	// split input in sentences
+	for _, val := range d.sentences(r.sentencesplitter) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sts, err := r.analyzesentence(val.Text)
+		if err != nil {
+			return nil, err
+		}
+		ts.add(sts)
+	}
+	return &ts, nil
+}
+
+// newtextstatistics returns empty statistics of the language of the engine
+func (r *Readability) newtextstatistics() TextStatistics {
+	return TextStatistics{Lang: r.lang, NoSyllables: r.syllables == nil}
+}
+
+// analyzesentence gathers the statistics of a single sentence.
+// A sentence without words, like a trailing remainder of white space, punctuation or numbers, is not counted.
+func (r *Readability) analyzesentence(sentence string) (TextStatistics, error) {
+
+	ts := r.newtextstatistics()
+
+	// split sentences into words
+	words, err := r.wordsplitter.Words(sentence)
+	if err != nil {
+		return ts, err
+	}
+
+	for _, w := range words {
+
+		if w.Kind == Number {
+			ts.Numbers++
+			ts.Characters += utf8.RuneCountInString(w.Text)
+			continue
+		}
+
+		word := w.Text
+		wa := r.annotateword(word)
+
+		if wa.Polysyllabic {
+			ts.Polysyllables++
+		} else if wa.Monosyllabic {
+			ts.Monosyllables++
+		}
+		ts.Syllables += wa.Syllables
+
+		if wa.LongWord {
+			ts.LongWords++
+		}
+		if wa.Nominalization {
+			ts.Nominalizations++
+		}
+		for _, c := range word {
+			if unicode.IsLetter(c) {
+				ts.Letters++
+			}
+			if unicode.IsLetter(c) || unicode.IsDigit(c) {
+				ts.Characters++
+			}
+		}
+		ts.Words++
+	}
+	if ts.Words > 0 {
+		ts.Sentences = 1
+	}
+	return ts, nil
+}
+
+// add accumulates the counts of other into ts
+func (ts *TextStatistics) add(other TextStatistics) {
+	ts.Sentences += other.Sentences
+	ts.Words += other.Words
+	ts.Numbers += other.Numbers
+	ts.Syllables += other.Syllables
+	ts.NoSyllables = ts.NoSyllables || other.NoSyllables
+	ts.Polysyllables += other.Polysyllables
+	ts.Monosyllables += other.Monosyllables
+	ts.LongWords += other.LongWords
+	ts.Characters += other.Characters
+	ts.Letters += other.Letters
+	ts.Nominalizations += other.Nominalizations
+}
@@ -0,0 +1,154 @@
+package readability
+
+import (
+	"context"
+	"unicode/utf8"
+)
+
+// WordAnnotation describes a single word of an analyzed text and how it is classified by the readability formulas
+type WordAnnotation struct {
+	Text           string `description:"the word"`
+	Start          int    `description:"byte offset of the word start within the analyzed text"`
+	End            int    `description:"byte offset of the word end within the analyzed text"`
+	RuneStart      int    `description:"rune offset of the word start within the analyzed text"`
+	RuneEnd        int    `description:"rune offset of the word end within the analyzed text"`
+	Hyphens        []int  `description:"rune offsets within the word where the syllable counter splits syllables"`
+	Syllables      int    `description:"number of syllables, 0 if the language has no hyphenation patterns"`
+	LongWord       bool   `description:"word is longer than six characters"`
+	Polysyllabic   bool   `description:"word counts as having three or more syllables"`
+	Monosyllabic   bool   `description:"word counts as having one syllable"`
+	Nominalization bool   `description:"german only: word is a noun derived by a nominalization suffix"`
+}
+
+// AnnotateWords splits text into sentences and words and returns the annotation of every word in text order
+func (r *Readability) AnnotateWords(text string) ([]WordAnnotation, error) {
+	return r.AnnotateDocumentWords(NewDocument(text, PlainText))
+}
+
+// AnnotateDocumentWords returns the annotation of every word of a Document in text order.
+// Offsets are within the original text, Text is the word without markup.
+func (r *Readability) AnnotateDocumentWords(d *Document) ([]WordAnnotation, error) {
+	return r.AnnotateDocumentWordsContext(context.Background(), d)
+}
+
+// AnnotateDocumentWordsContext is AnnotateDocumentWords, checking ctx before every sentence.
+func (r *Readability) AnnotateDocumentWordsContext(ctx context.Context, d *Document) ([]WordAnnotation, error) {
+
+	var result []WordAnnotation
+	var byteoffset, runeoffset int
+
+	for _, val := range d.sentences(r.sentencesplitter) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		words, err := r.wordsplitter.Words(val.Text)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range words {
+			if w.Kind != Letters {
+				continue
+			}
+
+			// advance the rune offset to the word start, sentences and words need not be contiguous
+			start, end := d.OriginalOffset(val.Start+w.Start), d.originalend(val.Start+w.End)
+			runeoffset += utf8.RuneCountInString(d.original[byteoffset:start])
+			byteoffset = start
+
+			wa := r.annotateword(w.Text)
+			wa.Start, wa.End = start, end
+			wa.RuneStart, wa.RuneEnd = runeoffset, runeoffset+utf8.RuneCountInString(d.original[start:end])
+			result = append(result, wa)
+		}
+	}
+	return result, nil
+}
+
+// annotateword hyphenates word, counts its syllables and classifies it for the readability formulas
+func (r *Readability) annotateword(word string) WordAnnotation {
+
+	wordlen := utf8.RuneCountInString(word)
+
+	// without a syllable counter there is no syllable data
+	if r.syllables == nil {
+		return WordAnnotation{Text: word, LongWord: wordlen > 6, Nominalization: baselanguage(r.lang) == "de" && isnominalization(word)}
+	}
+
+	var syllables int
+	var hyphens []int
+	if hyp, exception := r.exceptions.hyphenate(word); exception {
+		// every hyphen of an exception separates two syllables, whatever the counter would count
+		syllables, hyphens = len(hyp)+1, hyp
+	} else {
+		syllables = r.syllables.Syllables(word)
+		// leave out the hyphenation points the counter discards, custom counters get all of them
+		if b, ok := r.syllables.(syllablebreaker); ok {
+			hyphens = b.breaks(word)
+		} else {
+			hyphens = r.Hyphenate(word)
+		}
+	}
+
+	return WordAnnotation{
+		Text:           word,
+		Hyphens:        hyphens,
+		Syllables:      syllables,
+		LongWord:       wordlen > 6,
+		Polysyllabic:   syllables >= 3,
+		Monosyllabic:   syllables == 1,
+		Nominalization: baselanguage(r.lang) == "de" && isnominalization(word),
+	}
+}
+
+// hyphenate returns the hyphenation points of word from the exceptions or the patterns, dropping those too close to the word boundaries
+func (r *Readability) hyphenate(word string, wordlen int) []int {
+	hyp, exception := r.exceptions.hyphenate(word)
+	if !exception {
+		if r.normalize != nil {
+			if normalized := r.normalize(word); normalized != word {
+				return r.hyphenatenormalized(word, normalized)
+			}
+		}
+		hyp = r.hyphen.Hyphenate(word)
+	}
+	if r.lefthyphenmin == 0 && r.righthyphenmin == 0 {
+		return hyp
+	}
+
+	var result []int
+	for _, pos := range hyp {
+		if pos >= r.lefthyphenmin && pos <= wordlen-r.righthyphenmin {
+			result = append(result, pos)
+		}
+	}
+	return result
+}
+
+// hyphenatenormalized hyphenates the normalized form of word and maps the hyphenation points back onto word.
+// A point within the expansion of a single character, like ß to ss, is moved in front of that character.
+func (r *Readability) hyphenatenormalized(word, normalized string) []int {
+
+	// original position of every position in the normalized word
+	var positions []int
+	var pos int
+	for _, c := range word {
+		for range r.normalize(string(c)) {
+			positions = append(positions, pos)
+		}
+		pos++
+	}
+	positions = append(positions, pos)
+
+	var result []int
+	for _, p := range r.hyphenate(normalized, utf8.RuneCountInString(normalized)) {
+		if p >= len(positions) {
+			continue
+		}
+		if mapped := positions[p]; len(result) == 0 || result[len(result)-1] != mapped {
+			result = append(result, mapped)
+		}
+	}
+	return result
+}